@@ -0,0 +1,128 @@
+// Command apikey manages the API keys in the file-backed key store (AUTH_KEY_FILE), for example to
+// create the first admin key before enabling authentication. A running server picks up changes
+// without a restart.
+//
+// Usage:
+//
+//	apikey create -name NAME [-scopes weather:read,admin] [-expires 720h]
+//	apikey list
+//	apikey revoke ID
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"weather-api/internal/core/domain/entity"
+	"weather-api/internal/core/service"
+	"weather-api/internal/infrastructure/adapter/keystore"
+	"weather-api/internal/infrastructure/config"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+	}
+
+	cfg := config.LoadConfig()
+	store, err := keystore.NewFileStore(cfg.Auth.KeyFile)
+	if err != nil {
+		fail(err)
+	}
+	keyService := service.NewAPIKeyService(store)
+	ctx := context.Background()
+
+	switch os.Args[1] {
+	case "create":
+		create(ctx, keyService, os.Args[2:])
+	case "list":
+		list(ctx, keyService)
+	case "revoke":
+		if len(os.Args) != 3 {
+			usage()
+		}
+		key, err := keyService.RevokeAPIKey(ctx, os.Args[2])
+		if err != nil {
+			fail(err)
+		}
+		fmt.Printf("revoked %s (%s) at %s\n", key.ID, key.Name, key.RevokedAt.Format(time.RFC3339))
+	default:
+		usage()
+	}
+}
+
+func create(ctx context.Context, keyService *service.APIKeyService, args []string) {
+	flags := flag.NewFlagSet("create", flag.ExitOnError)
+	name := flags.String("name", "", "key name, e.g. the client it is issued to (required)")
+	scopeList := flags.String("scopes", string(entity.ScopeWeatherRead), "comma separated scopes: weather:read, admin")
+	expires := flags.Duration("expires", 0, "lifetime of the key, e.g. 720h (default: never expires)")
+	_ = flags.Parse(args)
+	if *name == "" {
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	var scopes []entity.APIKeyScope
+	for _, scope := range strings.Split(*scopeList, ",") {
+		if scope = strings.TrimSpace(scope); scope != "" {
+			scopes = append(scopes, entity.APIKeyScope(scope))
+		}
+	}
+	var expiresAt *time.Time
+	if *expires > 0 {
+		at := time.Now().Add(*expires)
+		expiresAt = &at
+	}
+
+	key, secret, err := keyService.CreateAPIKey(ctx, *name, scopes, expiresAt)
+	if err != nil {
+		fail(err)
+	}
+	fmt.Fprintf(os.Stderr, "created %s (%s); store the key now, it cannot be shown again:\n", key.ID, key.Name)
+	fmt.Println(secret)
+}
+
+func list(ctx context.Context, keyService *service.APIKeyService) {
+	keys, err := keyService.ListAPIKeys(ctx)
+	if err != nil {
+		fail(err)
+	}
+
+	now := time.Now()
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tNAME\tPREFIX\tSCOPES\tCREATED\tEXPIRES\tSTATUS")
+	for _, key := range keys {
+		scopes := make([]string, len(key.Scopes))
+		for i, scope := range key.Scopes {
+			scopes[i] = string(scope)
+		}
+		expires := "never"
+		if key.ExpiresAt != nil {
+			expires = key.ExpiresAt.Format(time.RFC3339)
+		}
+		status := "active"
+		switch {
+		case key.Revoked():
+			status = "revoked"
+		case key.Expired(now):
+			status = "expired"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", key.ID, key.Name, key.Prefix, strings.Join(scopes, ","), key.CreatedAt.Format(time.RFC3339), expires, status)
+	}
+	_ = w.Flush()
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage:\n  apikey create -name NAME [-scopes weather:read,admin] [-expires 720h]\n  apikey list\n  apikey revoke ID")
+	os.Exit(2)
+}
+
+func fail(err error) {
+	fmt.Fprintln(os.Stderr, "apikey:", err)
+	os.Exit(1)
+}
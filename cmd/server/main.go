@@ -20,6 +20,11 @@ import (
 // @host localhost:8080
 
 // @BasePath /
+
+// @securityDefinitions.apikey ApiKeyAuth
+// @in header
+// @name X-API-Key
+// @description API key issued with the apikey CLI or POST /admin/keys. Required on every route except /health, /livez and /readyz when AUTH_ENABLED=true; "Authorization: Bearer <key>" is accepted too.
 func main() {
 	server.Run()
 }
@@ -1,5 +1,4 @@
-// Package docs GENERATED BY SWAG; DO NOT EDIT
-// This file was generated by swaggo/swag
+// Package docs Code generated by swaggo/swag. DO NOT EDIT
 package docs
 
 import "github.com/swaggo/swag"
@@ -25,9 +24,369 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
+        "/admin/breakers": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Reports the state of every upstream circuit breaker, the requests, successes and failures counted in its current window, and how many calls it has rejected.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Get circuit breaker states",
+                "responses": {
+                    "200": {
+                        "description": "Circuit breaker states",
+                        "schema": {
+                            "$ref": "#/definitions/dto.CircuitBreakersResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/keys": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Lists every API key, including expired and revoked ones. Secrets and hashes are never returned.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "List API keys",
+                "responses": {
+                    "200": {
+                        "description": "All keys",
+                        "schema": {
+                            "$ref": "#/definitions/dto.APIKeyListResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid API key",
+                        "schema": {
+                            "$ref": "#/definitions/dto.APIKeyListResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "API key lacks the admin scope",
+                        "schema": {
+                            "$ref": "#/definitions/dto.APIKeyListResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.APIKeyListResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Creates an API key with the given scopes (weather:read, admin) and optional expiry. The secret is returned in ` + "`" + `key` + "`" + ` only in this response; only its hash is stored.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Create an API key",
+                "parameters": [
+                    {
+                        "description": "Key name, scopes and optional expiry",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateAPIKeyRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Key created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreatedAPIKeyResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid name, scope or expiry",
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreatedAPIKeyResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid API key",
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreatedAPIKeyResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "API key lacks the admin scope",
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreatedAPIKeyResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreatedAPIKeyResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/keys/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Revokes an API key immediately. The key stays listed with its revocation time; revoking it again is a no-op.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Revoke an API key",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "API key ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Key revoked",
+                        "schema": {
+                            "$ref": "#/definitions/dto.APIKeyResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid API key",
+                        "schema": {
+                            "$ref": "#/definitions/dto.APIKeyResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "API key lacks the admin scope",
+                        "schema": {
+                            "$ref": "#/definitions/dto.APIKeyResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "No key with this ID",
+                        "schema": {
+                            "$ref": "#/definitions/dto.APIKeyResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.APIKeyResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/quota": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Reports the OpenWeather calls made in the current minute and UTC day against each endpoint's budget (2.5 weather APIs and One Call 3.0), and how many calls were refused because a budget was used up.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Get upstream quota usage",
+                "responses": {
+                    "200": {
+                        "description": "Quota usage; enabled is false when no quota is enforced",
+                        "schema": {
+                            "$ref": "#/definitions/dto.QuotaUsageResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/shadow": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Reports how often the shadow candidate provider's answers diverged from the primary provider, per operation and field.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Get shadow traffic statistics",
+                "responses": {
+                    "200": {
+                        "description": "Shadow statistics; enabled is false when shadow mode is off",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ShadowStatsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/geo/reverse": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Returns the named locations closest to a coordinate.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Geocoding"
+                ],
+                "summary": "Reverse geocode a coordinate",
+                "parameters": [
+                    {
+                        "type": "number",
+                        "description": "Lat",
+                        "name": "lat",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "number",
+                        "description": "Lon",
+                        "name": "lon",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of matches (1-5, default 5)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Matching locations (possibly empty)",
+                        "schema": {
+                            "$ref": "#/definitions/dto.LocationsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request (e.g., lat out of range)",
+                        "schema": {
+                            "$ref": "#/definitions/dto.LocationsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.LocationsResponse"
+                        }
+                    },
+                    "501": {
+                        "description": "Not supported by the configured weather provider",
+                        "schema": {
+                            "$ref": "#/definitions/dto.LocationsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/geo/search": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Resolves a place name (optionally with state and country code, e.g. \"Paris, FR\") to matching locations.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Geocoding"
+                ],
+                "summary": "Search locations by name",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Place name, e.g. Paris,FR or Paris,TX,US",
+                        "name": "q",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of matches (1-5, default 5)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Matching locations (possibly empty)",
+                        "schema": {
+                            "$ref": "#/definitions/dto.LocationsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request (e.g., q is missing)",
+                        "schema": {
+                            "$ref": "#/definitions/dto.LocationsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.LocationsResponse"
+                        }
+                    }
+                }
+            }
+        },
         "/health": {
             "get": {
-                "description": "Checks if the weather service is up and running.",
+                "description": "Checks if the weather service is up and running. Kept for compatibility; use /livez and /readyz for orchestrator probes.",
                 "consumes": [
                     "application/json"
                 ],
@@ -51,115 +410,2219 @@ const docTemplate = `{
                 }
             }
         },
-        "/weather/overview": {
-            "get": {
-                "description": "Retrieves the current weather overview information for a given lat lon.",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Weather"
-                ],
-                "summary": "Get weather Overview by Lat Lon",
-                "parameters": [
-                    {
-                        "type": "number",
-                        "description": "Lat",
-                        "name": "lat",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "number",
-                        "description": "Lon",
-                        "name": "lon",
-                        "in": "query",
-                        "required": true
+        "/livez": {
+            "get": {
+                "description": "Reports that the process is up and serving requests. It checks no dependency, so a failing upstream never gets the process restarted.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Health"
+                ],
+                "summary": "Liveness probe",
+                "responses": {
+                    "200": {
+                        "description": "The process is alive",
+                        "schema": {
+                            "$ref": "#/definitions/dto.LivenessResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/readyz": {
+            "get": {
+                "description": "Runs the readiness checks (upstream probe, circuit breakers, API key store) and reports each check's status and latency. A single open circuit breaker only degrades the service; it is unready once every breaker of the primary provider is open. Upstream probe results are reused for HEALTH_PROBE_TTL so that probing cannot spend the upstream quota.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Health"
+                ],
+                "summary": "Readiness probe",
+                "responses": {
+                    "200": {
+                        "description": "No check is down; status is degraded when an optional check failed",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReadinessResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "At least one check is down",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReadinessResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/weather/air-quality": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieves the current air quality index and pollutant concentrations (PM2.5, PM10, O3, NO2, SO2, CO) for a coordinate.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Air Quality"
+                ],
+                "summary": "Get current air quality by Lat Lon",
+                "parameters": [
+                    {
+                        "type": "number",
+                        "description": "Lat",
+                        "name": "lat",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "number",
+                        "description": "Lon",
+                        "name": "lon",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully retrieved air quality data",
+                        "schema": {
+                            "$ref": "#/definitions/dto.AirQualityResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request (e.g., lat out of range)",
+                        "schema": {
+                            "$ref": "#/definitions/dto.AirQualityResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Air quality data not found for the specified location",
+                        "schema": {
+                            "$ref": "#/definitions/dto.AirQualityResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.AirQualityResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/weather/air-quality/forecast": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieves the hourly air quality forecast for the next days for a coordinate.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Air Quality"
+                ],
+                "summary": "Get air quality forecast by Lat Lon",
+                "parameters": [
+                    {
+                        "type": "number",
+                        "description": "Lat",
+                        "name": "lat",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "number",
+                        "description": "Lon",
+                        "name": "lon",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully retrieved air quality forecast",
+                        "schema": {
+                            "$ref": "#/definitions/dto.AirQualityResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request (e.g., lat out of range)",
+                        "schema": {
+                            "$ref": "#/definitions/dto.AirQualityResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Air quality data not found for the specified location",
+                        "schema": {
+                            "$ref": "#/definitions/dto.AirQualityResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.AirQualityResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/weather/air-quality/history": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieves hourly air quality readings between start and end (RFC3339) for a coordinate.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Air Quality"
+                ],
+                "summary": "Get historical air quality by Lat Lon",
+                "parameters": [
+                    {
+                        "type": "number",
+                        "description": "Lat",
+                        "name": "lat",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "number",
+                        "description": "Lon",
+                        "name": "lon",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Range start (RFC3339)",
+                        "name": "start",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Range end (RFC3339)",
+                        "name": "end",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully retrieved air quality history",
+                        "schema": {
+                            "$ref": "#/definitions/dto.AirQualityResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request (e.g., end before start)",
+                        "schema": {
+                            "$ref": "#/definitions/dto.AirQualityResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Air quality data not found for the specified location",
+                        "schema": {
+                            "$ref": "#/definitions/dto.AirQualityResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.AirQualityResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/weather/alerts": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieves national agency alerts for a coordinate with a normalized severity, most severe first.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Alerts"
+                ],
+                "summary": "Get weather alerts by Lat Lon",
+                "parameters": [
+                    {
+                        "type": "number",
+                        "description": "Lat",
+                        "name": "lat",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "number",
+                        "description": "Lon",
+                        "name": "lon",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Minimum severity: unknown, minor, moderate, severe, extreme (default: unknown)",
+                        "name": "min_severity",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only alerts in effect at this time (RFC3339)",
+                        "name": "active_at",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully retrieved alerts (possibly empty)",
+                        "schema": {
+                            "$ref": "#/definitions/dto.AlertsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request (e.g., unknown min_severity)",
+                        "schema": {
+                            "$ref": "#/definitions/dto.AlertsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Weather data not found for the specified location",
+                        "schema": {
+                            "$ref": "#/definitions/dto.AlertsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.AlertsResponse"
+                        }
+                    },
+                    "501": {
+                        "description": "Not supported by the configured weather provider",
+                        "schema": {
+                            "$ref": "#/definitions/dto.AlertsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/weather/batch": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieves the current weather for a list of cities and/or coordinates in one request. Each item names either a city or a lat/lon pair. Items are fetched concurrently and reported with their own success flag, HTTP status and error, so one failing location does not fail the batch.\nBy default the response is a single JSON document in request order. With ` + "`" + `Accept: application/x-ndjson` + "`" + ` it is streamed instead: one dto.BatchWeatherItemData object per line, written as each location completes (in completion order, tagged with its input index). Streamed batches accept more items, and disconnecting stops the remaining lookups.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "Weather"
+                ],
+                "summary": "Get weather for many locations",
+                "parameters": [
+                    {
+                        "description": "Locations to look up",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.BatchWeatherRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Unit system: metric (default), imperial or standard",
+                        "name": "units",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Description language, e.g. de or pt_br (default: best match from Accept-Language, then en)",
+                        "name": "lang",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Per-item results",
+                        "schema": {
+                            "$ref": "#/definitions/dto.BatchWeatherResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Malformed body, or the batch is empty or larger than the configured maximum",
+                        "schema": {
+                            "$ref": "#/definitions/dto.BatchWeatherResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.BatchWeatherResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/weather/history": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieves the weather recorded at a coordinate at a past point in time.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "History"
+                ],
+                "summary": "Get historical weather at a timestamp",
+                "parameters": [
+                    {
+                        "type": "number",
+                        "description": "Lat",
+                        "name": "lat",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "number",
+                        "description": "Lon",
+                        "name": "lon",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Timestamp (RFC3339), e.g. 2024-01-15T14:00:00Z",
+                        "name": "at",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Unit system: metric (default), imperial or standard",
+                        "name": "units",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Description language, e.g. de or pt_br (default: best match from Accept-Language, then en)",
+                        "name": "lang",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully retrieved historical weather",
+                        "schema": {
+                            "$ref": "#/definitions/dto.HistoricalWeatherResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request (e.g., at is not RFC3339)",
+                        "schema": {
+                            "$ref": "#/definitions/dto.HistoricalWeatherResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "No history for the specified location",
+                        "schema": {
+                            "$ref": "#/definitions/dto.HistoricalWeatherResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.HistoricalWeatherResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/weather/history/range": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieves one day summary per day between from and to (inclusive, at most 31 days) with range totals.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "History"
+                ],
+                "summary": "Get historical weather over a date range",
+                "parameters": [
+                    {
+                        "type": "number",
+                        "description": "Lat",
+                        "name": "lat",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "number",
+                        "description": "Lon",
+                        "name": "lon",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "First day (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last day (YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Unit system: metric (default), imperial or standard",
+                        "name": "units",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully retrieved historical range",
+                        "schema": {
+                            "$ref": "#/definitions/dto.HistoricalRangeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request (e.g., range too long)",
+                        "schema": {
+                            "$ref": "#/definitions/dto.HistoricalRangeResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "No history for the specified location",
+                        "schema": {
+                            "$ref": "#/definitions/dto.HistoricalRangeResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.HistoricalRangeResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/weather/onecall": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieves current, minutely, hourly, daily and alert blocks for a coordinate. Use include to request only some blocks.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Weather"
+                ],
+                "summary": "Get One Call weather data by Lat Lon",
+                "parameters": [
+                    {
+                        "type": "number",
+                        "description": "Lat",
+                        "name": "lat",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "number",
+                        "description": "Lon",
+                        "name": "lon",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated blocks: current,minutely,hourly,daily,alerts (default: all)",
+                        "name": "include",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Unit system: metric (default), imperial or standard",
+                        "name": "units",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Description language, e.g. de or pt_br (default: best match from Accept-Language, then en)",
+                        "name": "lang",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully retrieved weather data",
+                        "schema": {
+                            "$ref": "#/definitions/dto.OneCallResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request (e.g., unknown include block)",
+                        "schema": {
+                            "$ref": "#/definitions/dto.OneCallResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Weather data not found for the specified location",
+                        "schema": {
+                            "$ref": "#/definitions/dto.OneCallResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.OneCallResponse"
+                        }
+                    },
+                    "501": {
+                        "description": "Not supported by the configured weather provider",
+                        "schema": {
+                            "$ref": "#/definitions/dto.OneCallResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/weather/overview": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieves the current weather overview information for a given lat lon.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Weather"
+                ],
+                "summary": "Get weather Overview by Lat Lon",
+                "parameters": [
+                    {
+                        "type": "number",
+                        "description": "Lat",
+                        "name": "lat",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "number",
+                        "description": "Lon",
+                        "name": "lon",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Unit system: metric (default), imperial or standard",
+                        "name": "units",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully retrieved weather data",
+                        "schema": {
+                            "$ref": "#/definitions/dto.WeatherOverviewResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request (e.g., city name is missing)",
+                        "schema": {
+                            "$ref": "#/definitions/dto.WeatherOverviewResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Weather data not found for the specified city",
+                        "schema": {
+                            "$ref": "#/definitions/dto.WeatherOverviewResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.WeatherOverviewResponse"
+                        }
+                    },
+                    "501": {
+                        "description": "Not supported by the configured weather provider",
+                        "schema": {
+                            "$ref": "#/definitions/dto.WeatherOverviewResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/weather/{city}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieves the current weather information for a given city name.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Weather"
+                ],
+                "summary": "Get weather by city",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "City name",
+                        "name": "city",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Unit system: metric (default), imperial or standard",
+                        "name": "units",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Description language, e.g. de or pt_br (default: best match from Accept-Language, then en)",
+                        "name": "lang",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully retrieved weather data",
+                        "schema": {
+                            "$ref": "#/definitions/dto.WeatherResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request (e.g., city name is missing)",
+                        "schema": {
+                            "$ref": "#/definitions/dto.WeatherResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Weather data not found for the specified city",
+                        "schema": {
+                            "$ref": "#/definitions/dto.WeatherResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.WeatherResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/weather/{city}/forecast": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Retrieves the 5 day forecast in 3 hour slots for a given city name, ordered by time.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Weather"
+                ],
+                "summary": "Get forecast by city",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "City name",
+                        "name": "city",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Unit system: metric (default), imperial or standard",
+                        "name": "units",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Description language, e.g. de or pt_br (default: best match from Accept-Language, then en)",
+                        "name": "lang",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully retrieved forecast data",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ForecastResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request (e.g., city name is missing)",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ForecastResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Forecast not found for the specified city",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ForecastResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ForecastResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "dto.APIKeyData": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2024-01-09T14:00:00Z"
+                },
+                "expires_at": {
+                    "type": "string",
+                    "example": "2025-01-01T00:00:00Z"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "3f9c1a7be2d04c15"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "nightly-store-sync"
+                },
+                "prefix": {
+                    "type": "string",
+                    "example": "wapi_Xk3q9Z"
+                },
+                "revoked_at": {
+                    "type": "string",
+                    "example": "2024-06-01T08:30:00Z"
+                },
+                "scopes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "weather:read"
+                    ]
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "active",
+                        "expired",
+                        "revoked"
+                    ],
+                    "example": "active"
+                }
+            }
+        },
+        "dto.APIKeyListResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.APIKeyData"
+                    }
+                },
+                "error": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "dto.APIKeyResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/dto.APIKeyData"
+                },
+                "error": {
+                    "type": "string",
+                    "example": "api key not found"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "dto.AirComponentsData": {
+            "type": "object",
+            "properties": {
+                "co": {
+                    "type": "number",
+                    "example": 201.94
+                },
+                "nh3": {
+                    "type": "number",
+                    "example": 0.12
+                },
+                "no": {
+                    "type": "number",
+                    "example": 0.02
+                },
+                "no2": {
+                    "type": "number",
+                    "example": 0.77
+                },
+                "o3": {
+                    "type": "number",
+                    "example": 68.66
+                },
+                "pm10": {
+                    "type": "number",
+                    "example": 0.54
+                },
+                "pm2_5": {
+                    "type": "number",
+                    "example": 0.5
+                },
+                "so2": {
+                    "type": "number",
+                    "example": 0.64
+                }
+            }
+        },
+        "dto.AirQualityData": {
+            "type": "object",
+            "properties": {
+                "lat": {
+                    "type": "number",
+                    "example": 38.4
+                },
+                "lon": {
+                    "type": "number",
+                    "example": 27.1
+                },
+                "provider": {
+                    "type": "string",
+                    "example": "openweather"
+                },
+                "readings": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.AirQualityReadingData"
+                    }
+                }
+            }
+        },
+        "dto.AirQualityReadingData": {
+            "type": "object",
+            "properties": {
+                "aqi": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "aqi_label": {
+                    "type": "string",
+                    "example": "fair"
+                },
+                "components": {
+                    "$ref": "#/definitions/dto.AirComponentsData"
+                },
+                "time": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.AirQualityResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/dto.AirQualityData"
+                },
+                "error": {
+                    "type": "string",
+                    "example": "end must be after start"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "dto.AlertsResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.WeatherAlertData"
+                    }
+                },
+                "error": {
+                    "type": "string",
+                    "example": "unknown min_severity 'high'"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "dto.BatchWeatherData": {
+            "type": "object",
+            "properties": {
+                "failed": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.BatchWeatherItemData"
+                    }
+                },
+                "succeeded": {
+                    "type": "integer",
+                    "example": 39
+                }
+            }
+        },
+        "dto.BatchWeatherItemData": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/dto.WeatherData"
+                },
+                "error": {
+                    "type": "string",
+                    "example": "city 'Atlantis' not found"
+                },
+                "index": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "query": {
+                    "$ref": "#/definitions/dto.BatchWeatherItemRequest"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 200
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "dto.BatchWeatherItemRequest": {
+            "type": "object",
+            "properties": {
+                "city": {
+                    "type": "string",
+                    "minLength": 2,
+                    "example": "London"
+                },
+                "lat": {
+                    "type": "number",
+                    "maximum": 90,
+                    "minimum": -90,
+                    "example": 51.5
+                },
+                "lon": {
+                    "type": "number",
+                    "maximum": 180,
+                    "minimum": -180,
+                    "example": -0.12
+                }
+            }
+        },
+        "dto.BatchWeatherRequest": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.BatchWeatherItemRequest"
+                    }
+                }
+            }
+        },
+        "dto.BatchWeatherResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/dto.BatchWeatherData"
+                },
+                "error": {
+                    "type": "string",
+                    "example": "batch must contain between 1 and 50 items"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "dto.CircuitBreakerData": {
+            "type": "object",
+            "properties": {
+                "consecutive_failures": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "failures": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "name": {
+                    "type": "string",
+                    "example": "openweather-onecall"
+                },
+                "rejected": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "requests": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "state": {
+                    "type": "string",
+                    "example": "closed"
+                },
+                "successes": {
+                    "type": "integer",
+                    "example": 11
+                }
+            }
+        },
+        "dto.CircuitBreakersResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.CircuitBreakerData"
+                    }
+                },
+                "error": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "dto.CreateAPIKeyRequest": {
+            "type": "object",
+            "required": [
+                "name",
+                "scopes"
+            ],
+            "properties": {
+                "expires_at": {
+                    "type": "string",
+                    "example": "2025-01-01T00:00:00Z"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "nightly-store-sync"
+                },
+                "scopes": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "weather:read"
+                    ]
+                }
+            }
+        },
+        "dto.CreatedAPIKeyData": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2024-01-09T14:00:00Z"
+                },
+                "expires_at": {
+                    "type": "string",
+                    "example": "2025-01-01T00:00:00Z"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "3f9c1a7be2d04c15"
+                },
+                "key": {
+                    "type": "string",
+                    "example": "wapi_Xk3q9Z..."
+                },
+                "name": {
+                    "type": "string",
+                    "example": "nightly-store-sync"
+                },
+                "prefix": {
+                    "type": "string",
+                    "example": "wapi_Xk3q9Z"
+                },
+                "revoked_at": {
+                    "type": "string",
+                    "example": "2024-06-01T08:30:00Z"
+                },
+                "scopes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "weather:read"
+                    ]
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "active",
+                        "expired",
+                        "revoked"
+                    ],
+                    "example": "active"
+                }
+            }
+        },
+        "dto.CreatedAPIKeyResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/dto.CreatedAPIKeyData"
+                },
+                "error": {
+                    "type": "string",
+                    "example": "invalid api key scope: weather:write"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "dto.CurrentConditionsData": {
+            "type": "object",
+            "properties": {
+                "clouds": {
+                    "type": "integer",
+                    "example": 40
+                },
+                "description": {
+                    "type": "string",
+                    "example": "scattered clouds"
+                },
+                "dew_point": {
+                    "type": "number",
+                    "example": 9.1
+                },
+                "feels_like": {
+                    "type": "number",
+                    "example": 14.8
+                },
+                "humidity": {
+                    "type": "integer",
+                    "example": 80
+                },
+                "pressure": {
+                    "type": "integer",
+                    "example": 1015
+                },
+                "sunrise": {
+                    "type": "string"
+                },
+                "sunset": {
+                    "type": "string"
+                },
+                "temperature": {
+                    "type": "number",
+                    "example": 15.5
+                },
+                "time": {
+                    "type": "string"
+                },
+                "uv_index": {
+                    "type": "number",
+                    "example": 2.3
+                },
+                "visibility": {
+                    "type": "integer",
+                    "example": 10000
+                },
+                "wind_deg": {
+                    "type": "integer",
+                    "example": 220
+                },
+                "wind_speed": {
+                    "type": "number",
+                    "example": 4.5
+                }
+            }
+        },
+        "dto.DailyConditionsData": {
+            "type": "object",
+            "properties": {
+                "date": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "light rain"
+                },
+                "humidity": {
+                    "type": "integer",
+                    "example": 72
+                },
+                "precipitation_probability": {
+                    "type": "number",
+                    "example": 0.6
+                },
+                "rain": {
+                    "type": "number",
+                    "example": 2.4
+                },
+                "summary": {
+                    "type": "string",
+                    "example": "Expect a day of partly cloudy with rain"
+                },
+                "temp_day": {
+                    "type": "number",
+                    "example": 15.1
+                },
+                "temp_max": {
+                    "type": "number",
+                    "example": 16.4
+                },
+                "temp_min": {
+                    "type": "number",
+                    "example": 9.2
+                },
+                "temp_night": {
+                    "type": "number",
+                    "example": 10.3
+                },
+                "uv_index": {
+                    "type": "number",
+                    "example": 3.1
+                },
+                "wind_speed": {
+                    "type": "number",
+                    "example": 5.2
+                }
+            }
+        },
+        "dto.DaySummaryData": {
+            "type": "object",
+            "properties": {
+                "cloud_cover_afternoon": {
+                    "type": "number",
+                    "example": 40
+                },
+                "date": {
+                    "type": "string",
+                    "example": "2024-01-15"
+                },
+                "humidity_afternoon": {
+                    "type": "number",
+                    "example": 62
+                },
+                "precipitation": {
+                    "type": "number",
+                    "example": 1.6
+                },
+                "pressure_afternoon": {
+                    "type": "number",
+                    "example": 1016
+                },
+                "temp_afternoon": {
+                    "type": "number",
+                    "example": 11.2
+                },
+                "temp_evening": {
+                    "type": "number",
+                    "example": 8.4
+                },
+                "temp_max": {
+                    "type": "number",
+                    "example": 11.8
+                },
+                "temp_min": {
+                    "type": "number",
+                    "example": 4.2
+                },
+                "temp_morning": {
+                    "type": "number",
+                    "example": 5.1
+                },
+                "temp_night": {
+                    "type": "number",
+                    "example": 5.9
+                },
+                "wind_max_direction": {
+                    "type": "number",
+                    "example": 250
+                },
+                "wind_max_speed": {
+                    "type": "number",
+                    "example": 8.7
+                }
+            }
+        },
+        "dto.ForecastData": {
+            "type": "object",
+            "properties": {
+                "city": {
+                    "type": "string",
+                    "example": "London"
+                },
+                "country": {
+                    "type": "string",
+                    "example": "GB"
+                },
+                "lang": {
+                    "type": "string",
+                    "example": "en"
+                },
+                "lat": {
+                    "type": "number",
+                    "example": 51.5085
+                },
+                "lon": {
+                    "type": "number",
+                    "example": -0.1257
+                },
+                "provider": {
+                    "type": "string",
+                    "example": "openweather"
+                },
+                "slots": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ForecastSlotData"
+                    }
+                },
+                "units": {
+                    "type": "string",
+                    "example": "metric"
+                }
+            }
+        },
+        "dto.ForecastResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/dto.ForecastData"
+                },
+                "error": {
+                    "type": "string",
+                    "example": "city not found"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "dto.ForecastSlotData": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "example": "light rain"
+                },
+                "feels_like": {
+                    "type": "number",
+                    "example": 14.8
+                },
+                "humidity": {
+                    "type": "integer",
+                    "example": 80
+                },
+                "precipitation_probability": {
+                    "type": "number",
+                    "example": 0.35
+                },
+                "temp_max": {
+                    "type": "number",
+                    "example": 16.1
+                },
+                "temp_min": {
+                    "type": "number",
+                    "example": 14.2
+                },
+                "temperature": {
+                    "type": "number",
+                    "example": 15.5
+                },
+                "time": {
+                    "type": "string"
+                },
+                "wind_speed": {
+                    "type": "number",
+                    "example": 4.5
+                }
+            }
+        },
+        "dto.HealthCheckData": {
+            "type": "object",
+            "properties": {
+                "cached": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "checked_at": {
+                    "type": "string",
+                    "example": "2024-01-01T12:00:00Z"
+                },
+                "error": {
+                    "type": "string",
+                    "example": "circuit breaker openweather-onecall is open"
+                },
+                "latency_ms": {
+                    "type": "number",
+                    "example": 84.2
+                },
+                "name": {
+                    "type": "string",
+                    "example": "upstream:openweather"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "up",
+                        "degraded",
+                        "down"
+                    ],
+                    "example": "up"
+                }
+            }
+        },
+        "dto.HistoricalObservationData": {
+            "type": "object",
+            "properties": {
+                "clouds": {
+                    "type": "integer",
+                    "example": 75
+                },
+                "description": {
+                    "type": "string",
+                    "example": "broken clouds"
+                },
+                "dew_point": {
+                    "type": "number",
+                    "example": 8.3
+                },
+                "feels_like": {
+                    "type": "number",
+                    "example": 11.2
+                },
+                "humidity": {
+                    "type": "integer",
+                    "example": 76
+                },
+                "pressure": {
+                    "type": "integer",
+                    "example": 1012
+                },
+                "temperature": {
+                    "type": "number",
+                    "example": 12.4
+                },
+                "time": {
+                    "type": "string"
+                },
+                "visibility": {
+                    "type": "integer",
+                    "example": 10000
+                },
+                "wind_deg": {
+                    "type": "integer",
+                    "example": 240
+                },
+                "wind_speed": {
+                    "type": "number",
+                    "example": 5.1
+                }
+            }
+        },
+        "dto.HistoricalRangeData": {
+            "type": "object",
+            "properties": {
+                "days": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.DaySummaryData"
+                    }
+                },
+                "from": {
+                    "type": "string",
+                    "example": "2024-01-15"
+                },
+                "lat": {
+                    "type": "number",
+                    "example": 38.4
+                },
+                "lon": {
+                    "type": "number",
+                    "example": 27.1
+                },
+                "max_wind_speed": {
+                    "type": "number",
+                    "example": 11.2
+                },
+                "temp_max": {
+                    "type": "number",
+                    "example": 13.4
+                },
+                "temp_mean": {
+                    "type": "number",
+                    "example": 7.6
+                },
+                "temp_min": {
+                    "type": "number",
+                    "example": 1.9
+                },
+                "to": {
+                    "type": "string",
+                    "example": "2024-01-21"
+                },
+                "total_precipitation": {
+                    "type": "number",
+                    "example": 12.3
+                },
+                "units": {
+                    "type": "string",
+                    "example": "metric"
+                }
+            }
+        },
+        "dto.HistoricalRangeResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/dto.HistoricalRangeData"
+                },
+                "error": {
+                    "type": "string",
+                    "example": "range must span between 1 and 31 days"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "dto.HistoricalWeatherData": {
+            "type": "object",
+            "properties": {
+                "lang": {
+                    "type": "string",
+                    "example": "en"
+                },
+                "lat": {
+                    "type": "number",
+                    "example": 38.4
+                },
+                "lon": {
+                    "type": "number",
+                    "example": 27.1
+                },
+                "observations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.HistoricalObservationData"
+                    }
+                },
+                "provider": {
+                    "type": "string",
+                    "example": "openweather"
+                },
+                "timezone": {
+                    "type": "string",
+                    "example": "Europe/Istanbul"
+                },
+                "units": {
+                    "type": "string",
+                    "example": "metric"
+                }
+            }
+        },
+        "dto.HistoricalWeatherResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/dto.HistoricalWeatherData"
+                },
+                "error": {
+                    "type": "string",
+                    "example": "at must be an RFC3339 timestamp"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "dto.HourlyConditionsData": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "example": "light rain"
+                },
+                "feels_like": {
+                    "type": "number",
+                    "example": 14.8
+                },
+                "humidity": {
+                    "type": "integer",
+                    "example": 80
+                },
+                "precipitation_probability": {
+                    "type": "number",
+                    "example": 0.35
+                },
+                "temperature": {
+                    "type": "number",
+                    "example": 15.5
+                },
+                "time": {
+                    "type": "string"
+                },
+                "wind_speed": {
+                    "type": "number",
+                    "example": 4.5
+                }
+            }
+        },
+        "dto.LivenessData": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "type": "string",
+                    "example": "alive"
+                }
+            }
+        },
+        "dto.LivenessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/dto.LivenessData"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "dto.LocationData": {
+            "type": "object",
+            "properties": {
+                "country": {
+                    "type": "string",
+                    "example": "FR"
+                },
+                "lat": {
+                    "type": "number",
+                    "example": 48.8589
+                },
+                "local_names": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "lon": {
+                    "type": "number",
+                    "example": 2.32
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Paris"
+                },
+                "state": {
+                    "type": "string",
+                    "example": "Ile-de-France"
+                }
+            }
+        },
+        "dto.LocationsResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.LocationData"
+                    }
+                },
+                "error": {
+                    "type": "string",
+                    "example": "q is required"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "dto.MinutelyPrecipitationData": {
+            "type": "object",
+            "properties": {
+                "precipitation": {
+                    "type": "number",
+                    "example": 0.25
+                },
+                "time": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.OneCallData": {
+            "type": "object",
+            "properties": {
+                "alerts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.WeatherAlertData"
+                    }
+                },
+                "current": {
+                    "$ref": "#/definitions/dto.CurrentConditionsData"
+                },
+                "daily": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.DailyConditionsData"
+                    }
+                },
+                "hourly": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.HourlyConditionsData"
+                    }
+                },
+                "lang": {
+                    "type": "string",
+                    "example": "en"
+                },
+                "lat": {
+                    "type": "number",
+                    "example": 38.4
+                },
+                "lon": {
+                    "type": "number",
+                    "example": 27.1
+                },
+                "minutely": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.MinutelyPrecipitationData"
+                    }
+                },
+                "provider": {
+                    "type": "string",
+                    "example": "openweather"
+                },
+                "timezone": {
+                    "type": "string",
+                    "example": "Europe/Istanbul"
+                },
+                "timezone_offset": {
+                    "type": "integer",
+                    "example": 10800
+                },
+                "units": {
+                    "type": "string",
+                    "example": "metric"
+                }
+            }
+        },
+        "dto.OneCallResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/dto.OneCallData"
+                },
+                "error": {
+                    "type": "string",
+                    "example": "unknown include block"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "dto.QuotaEndpointUsageData": {
+            "type": "object",
+            "properties": {
+                "day": {
+                    "$ref": "#/definitions/dto.QuotaWindowData"
+                },
+                "endpoint": {
+                    "type": "string",
+                    "example": "3.0"
+                },
+                "minute": {
+                    "$ref": "#/definitions/dto.QuotaWindowData"
+                },
+                "rejected": {
+                    "type": "integer",
+                    "example": 3
+                }
+            }
+        },
+        "dto.QuotaUsageData": {
+            "type": "object",
+            "properties": {
+                "enabled": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "endpoints": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.QuotaEndpointUsageData"
+                    }
+                }
+            }
+        },
+        "dto.QuotaUsageResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/dto.QuotaUsageData"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "dto.QuotaWindowData": {
+            "type": "object",
+            "properties": {
+                "limit": {
+                    "type": "integer",
+                    "example": 1000
+                },
+                "remaining": {
+                    "type": "integer",
+                    "example": 588
+                },
+                "reset_at": {
+                    "type": "string",
+                    "example": "2024-01-02T00:00:00Z"
+                },
+                "used": {
+                    "type": "integer",
+                    "example": 412
+                }
+            }
+        },
+        "dto.ReadinessData": {
+            "type": "object",
+            "properties": {
+                "checks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.HealthCheckData"
                     }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "Successfully retrieved weather data",
-                        "schema": {
-                            "$ref": "#/definitions/dto.WeatherOverviewResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Invalid request (e.g., city name is missing)",
-                        "schema": {
-                            "$ref": "#/definitions/dto.WeatherOverviewResponse"
-                        }
-                    },
-                    "404": {
-                        "description": "Weather data not found for the specified city",
-                        "schema": {
-                            "$ref": "#/definitions/dto.WeatherOverviewResponse"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal server error",
-                        "schema": {
-                            "$ref": "#/definitions/dto.WeatherOverviewResponse"
-                        }
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "ready",
+                        "degraded",
+                        "not_ready"
+                    ],
+                    "example": "ready"
+                }
+            }
+        },
+        "dto.ReadinessResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/dto.ReadinessData"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "dto.ShadowFieldStatsData": {
+            "type": "object",
+            "properties": {
+                "compared": {
+                    "type": "integer",
+                    "example": 120
+                },
+                "diverged": {
+                    "type": "integer",
+                    "example": 7
+                },
+                "max_abs_diff": {
+                    "type": "number",
+                    "example": 3.1
+                },
+                "mean_abs_diff": {
+                    "type": "number",
+                    "example": 0.6
+                }
+            }
+        },
+        "dto.ShadowOperationStatsData": {
+            "type": "object",
+            "properties": {
+                "candidate_errors": {
+                    "type": "integer",
+                    "example": 8
+                },
+                "compared": {
+                    "type": "integer",
+                    "example": 120
+                },
+                "divergent": {
+                    "type": "integer",
+                    "example": 9
+                },
+                "dropped": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "fields": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/dto.ShadowFieldStatsData"
                     }
+                },
+                "sampled": {
+                    "type": "integer",
+                    "example": 130
                 }
             }
         },
-        "/weather/{city}": {
-            "get": {
-                "description": "Retrieves the current weather information for a given city name.",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Weather"
-                ],
-                "summary": "Get weather by city",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "City name",
-                        "name": "city",
-                        "in": "path",
-                        "required": true
+        "dto.ShadowStatsData": {
+            "type": "object",
+            "properties": {
+                "candidate": {
+                    "type": "string",
+                    "example": "openmeteo"
+                },
+                "enabled": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "operations": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/dto.ShadowOperationStatsData"
                     }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "Successfully retrieved weather data",
-                        "schema": {
-                            "$ref": "#/definitions/dto.WeatherResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Invalid request (e.g., city name is missing)",
-                        "schema": {
-                            "$ref": "#/definitions/dto.WeatherResponse"
-                        }
-                    },
-                    "404": {
-                        "description": "Weather data not found for the specified city",
-                        "schema": {
-                            "$ref": "#/definitions/dto.WeatherResponse"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal server error",
-                        "schema": {
-                            "$ref": "#/definitions/dto.WeatherResponse"
-                        }
+                },
+                "sample_percent": {
+                    "type": "number",
+                    "example": 10
+                }
+            }
+        },
+        "dto.ShadowStatsResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/dto.ShadowStatsData"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "dto.WeatherAlertData": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "example": "Winds 15 to 20 kt with gusts up to 25 kt."
+                },
+                "end": {
+                    "type": "string"
+                },
+                "event": {
+                    "type": "string",
+                    "example": "Small Craft Advisory"
+                },
+                "sender_name": {
+                    "type": "string",
+                    "example": "NWS Philadelphia - Mount Holly"
+                },
+                "severity": {
+                    "type": "string",
+                    "enum": [
+                        "unknown",
+                        "minor",
+                        "moderate",
+                        "severe",
+                        "extreme"
+                    ],
+                    "example": "moderate"
+                },
+                "start": {
+                    "type": "string"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
                     }
                 }
             }
-        }
-    },
-    "definitions": {
+        },
+        "dto.WeatherConsensusData": {
+            "type": "object",
+            "properties": {
+                "failed_providers": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "humidity_spread": {
+                    "type": "number",
+                    "example": 4
+                },
+                "providers": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "openweather",
+                        "openmeteo"
+                    ]
+                },
+                "strategy": {
+                    "type": "string",
+                    "example": "median"
+                },
+                "temperature_spread": {
+                    "type": "number",
+                    "example": 0.8
+                },
+                "wind_speed_spread": {
+                    "type": "number",
+                    "example": 1.2
+                }
+            }
+        },
         "dto.WeatherData": {
             "type": "object",
             "properties": {
@@ -167,6 +2630,9 @@ const docTemplate = `{
                     "type": "string",
                     "example": "London"
                 },
+                "consensus": {
+                    "$ref": "#/definitions/dto.WeatherConsensusData"
+                },
                 "description": {
                     "type": "string",
                     "example": "scattered clouds"
@@ -175,6 +2641,14 @@ const docTemplate = `{
                     "type": "integer",
                     "example": 80
                 },
+                "lang": {
+                    "type": "string",
+                    "example": "en"
+                },
+                "provider": {
+                    "type": "string",
+                    "example": "openweather"
+                },
                 "temperature": {
                     "type": "number",
                     "example": 15.5
@@ -182,6 +2656,10 @@ const docTemplate = `{
                 "timestamp": {
                     "type": "string"
                 },
+                "units": {
+                    "type": "string",
+                    "example": "metric"
+                },
                 "wind_speed": {
                     "type": "number",
                     "example": 4.5
@@ -203,6 +2681,10 @@ const docTemplate = `{
                     "type": "number",
                     "example": 38.4
                 },
+                "provider": {
+                    "type": "string",
+                    "example": "openweather"
+                },
                 "tz": {
                     "type": "string",
                     "example": "+02:00"
@@ -249,6 +2731,14 @@ const docTemplate = `{
                 }
             }
         }
+    },
+    "securityDefinitions": {
+        "ApiKeyAuth": {
+            "description": "API key issued with the apikey CLI or POST /admin/keys. Required on every route except /health, /livez and /readyz when AUTH_ENABLED=true; \"Authorization: Bearer \u003ckey\u003e\" is accepted too.",
+            "type": "apiKey",
+            "name": "X-API-Key",
+            "in": "header"
+        }
     }
 }`
 
@@ -262,6 +2752,8 @@ var SwaggerInfo = &swag.Spec{
 	Description:      "A simple weather API service built with Go, Gin, and Hexagonal Architecture.",
 	InfoInstanceName: "swagger",
 	SwaggerTemplate:  docTemplate,
+	LeftDelim:        "{{",
+	RightDelim:       "}}",
 }
 
 func init() {
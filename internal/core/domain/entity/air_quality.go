@@ -0,0 +1,49 @@
+package entity
+
+import "time"
+
+// AirQuality is a series of air pollution readings for a coordinate.
+type AirQuality struct {
+	Lat      float32
+	Lon      float32
+	Readings []AirQualityReading
+	Provider string
+}
+
+// AirQualityReading is the air quality index and pollutant concentrations at a point in time.
+// AQI follows the OpenWeather scale from 1 (good) to 5 (very poor).
+type AirQualityReading struct {
+	Time       time.Time
+	AQI        int
+	Components AirComponents
+}
+
+// AirComponents holds pollutant concentrations in μg/m3.
+type AirComponents struct {
+	CO    float64
+	NO    float64
+	NO2   float64
+	O3    float64
+	SO2   float64
+	PM2_5 float64
+	PM10  float64
+	NH3   float64
+}
+
+// AQILabel returns the qualitative name for an AQI value on the 1-5 scale.
+func AQILabel(aqi int) string {
+	switch aqi {
+	case 1:
+		return "good"
+	case 2:
+		return "fair"
+	case 3:
+		return "moderate"
+	case 4:
+		return "poor"
+	case 5:
+		return "very poor"
+	default:
+		return "unknown"
+	}
+}
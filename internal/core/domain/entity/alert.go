@@ -0,0 +1,64 @@
+package entity
+
+import (
+	"strings"
+	"time"
+)
+
+// WeatherAlert is a warning issued by a national weather agency.
+// Severity is derived from the free-form event text by the service layer.
+type WeatherAlert struct {
+	SenderName  string
+	Event       string
+	Start       time.Time
+	End         time.Time
+	Description string
+	Tags        []string
+	Severity    AlertSeverity
+}
+
+// IsActiveAt reports whether the alert is in effect at t. An alert without an end stays active.
+func (a WeatherAlert) IsActiveAt(t time.Time) bool {
+	if t.Before(a.Start) {
+		return false
+	}
+	return a.End.IsZero() || t.Before(a.End)
+}
+
+// AlertSeverity is a normalized, ordered severity level for weather alerts.
+type AlertSeverity int
+
+const (
+	AlertSeverityUnknown AlertSeverity = iota
+	AlertSeverityMinor
+	AlertSeverityModerate
+	AlertSeveritySevere
+	AlertSeverityExtreme
+)
+
+var alertSeverityNames = map[AlertSeverity]string{
+	AlertSeverityUnknown:  "unknown",
+	AlertSeverityMinor:    "minor",
+	AlertSeverityModerate: "moderate",
+	AlertSeveritySevere:   "severe",
+	AlertSeverityExtreme:  "extreme",
+}
+
+// String returns the lowercase name of the severity.
+func (s AlertSeverity) String() string {
+	if name, ok := alertSeverityNames[s]; ok {
+		return name
+	}
+	return alertSeverityNames[AlertSeverityUnknown]
+}
+
+// ParseAlertSeverity converts a case-insensitive severity name into an AlertSeverity.
+func ParseAlertSeverity(name string) (AlertSeverity, bool) {
+	candidate := strings.ToLower(strings.TrimSpace(name))
+	for severity, severityName := range alertSeverityNames {
+		if severityName == candidate {
+			return severity, true
+		}
+	}
+	return AlertSeverityUnknown, false
+}
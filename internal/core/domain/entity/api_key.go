@@ -0,0 +1,52 @@
+package entity
+
+import (
+	"slices"
+	"time"
+)
+
+// APIKeyScope names a group of routes an API key may call.
+type APIKeyScope string
+
+const (
+	// ScopeWeatherRead allows the weather and geocoding routes.
+	ScopeWeatherRead APIKeyScope = "weather:read"
+	// ScopeAdmin allows the operational /admin routes, including key management.
+	ScopeAdmin APIKeyScope = "admin"
+)
+
+// APIKeyScopes lists every scope a key can be granted.
+var APIKeyScopes = []APIKeyScope{ScopeWeatherRead, ScopeAdmin}
+
+// Valid reports whether s is a known scope.
+func (s APIKeyScope) Valid() bool {
+	return slices.Contains(APIKeyScopes, s)
+}
+
+// APIKey is a client credential. Only a hash of the secret is kept; Prefix holds its first characters
+// so operators can recognize a key without being able to use it.
+type APIKey struct {
+	ID        string
+	Name      string
+	Prefix    string
+	Hash      string
+	Scopes    []APIKeyScope
+	CreatedAt time.Time
+	ExpiresAt *time.Time
+	RevokedAt *time.Time
+}
+
+// Expired reports whether the key has an expiry at or before now.
+func (k *APIKey) Expired(now time.Time) bool {
+	return k.ExpiresAt != nil && !now.Before(*k.ExpiresAt)
+}
+
+// Revoked reports whether the key has been revoked.
+func (k *APIKey) Revoked() bool {
+	return k.RevokedAt != nil
+}
+
+// HasScope reports whether the key was granted scope.
+func (k *APIKey) HasScope(scope APIKeyScope) bool {
+	return slices.Contains(k.Scopes, scope)
+}
@@ -0,0 +1,18 @@
+package entity
+
+// BatchQuery identifies one location of a batch lookup, either by city name or, when City is empty,
+// by coordinates.
+type BatchQuery struct {
+	City string
+	Lat  float32
+	Lon  float32
+}
+
+// BatchResult is the outcome of one batch query: the current weather or the error that prevented it.
+// Index is the position of Query in the batch.
+type BatchResult struct {
+	Index   int
+	Query   BatchQuery
+	Weather *Weather
+	Err     error
+}
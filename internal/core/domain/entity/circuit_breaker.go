@@ -0,0 +1,13 @@
+package entity
+
+// CircuitBreakerStatus reports the state of one upstream circuit breaker. The request counts cover the
+// breaker's current window, which starts over on every state change; Rejected counts every call it refused.
+type CircuitBreakerStatus struct {
+	Name                string
+	State               string
+	Requests            uint32
+	Successes           uint32
+	Failures            uint32
+	ConsecutiveFailures uint32
+	Rejected            uint64
+}
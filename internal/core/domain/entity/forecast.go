@@ -0,0 +1,33 @@
+package entity
+
+import (
+	"time"
+
+	"weather-api/pkg/language"
+	"weather-api/pkg/units"
+)
+
+// Forecast is a time-ordered series of forecast slots for a single location.
+type Forecast struct {
+	City     string
+	Country  string
+	Lat      float32
+	Lon      float32
+	Slots    []ForecastSlot
+	Units    units.System
+	Language language.Code
+	Provider string
+}
+
+// ForecastSlot holds the expected conditions for one forecast interval (3 hours for OpenWeather).
+type ForecastSlot struct {
+	Time                     time.Time
+	Temperature              float64
+	FeelsLike                float64
+	TempMin                  float64
+	TempMax                  float64
+	Description              string
+	Humidity                 int
+	WindSpeed                float64
+	PrecipitationProbability float64
+}
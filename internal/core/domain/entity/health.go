@@ -0,0 +1,32 @@
+package entity
+
+import "time"
+
+// HealthStatus is the outcome of one readiness check. An optional check that fails is degraded
+// rather than down.
+type HealthStatus string
+
+const (
+	HealthStatusUp       HealthStatus = "up"
+	HealthStatusDegraded HealthStatus = "degraded"
+	HealthStatusDown     HealthStatus = "down"
+)
+
+// HealthCheckResult is the outcome of one readiness check. Latency is how long the check took when
+// it last ran at CheckedAt; Cached is set when that earlier result was reused instead of running it again.
+type HealthCheckResult struct {
+	Name      string
+	Status    HealthStatus
+	Error     string
+	Latency   time.Duration
+	CheckedAt time.Time
+	Cached    bool
+}
+
+// HealthReport collects the readiness checks in registration order. The service is ready when no check
+// is down, and degraded when it is ready but an optional check failed.
+type HealthReport struct {
+	Ready    bool
+	Degraded bool
+	Checks   []HealthCheckResult
+}
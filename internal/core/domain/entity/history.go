@@ -0,0 +1,69 @@
+package entity
+
+import (
+	"time"
+
+	"weather-api/pkg/language"
+	"weather-api/pkg/units"
+)
+
+// HistoricalWeather holds the observations recorded at or around a past timestamp.
+type HistoricalWeather struct {
+	Lat          float32
+	Lon          float32
+	Timezone     string
+	Observations []HistoricalObservation
+	Units        units.System
+	Language     language.Code
+	Provider     string
+}
+
+// HistoricalObservation is the recorded weather at a single point in time.
+type HistoricalObservation struct {
+	Time        time.Time
+	Temperature float64
+	FeelsLike   float64
+	Pressure    int
+	Humidity    int
+	DewPoint    float64
+	Clouds      int
+	Visibility  int
+	WindSpeed   float64
+	WindDeg     int
+	Description string
+}
+
+// DaySummary aggregates the weather recorded over one calendar day.
+type DaySummary struct {
+	Lat                 float32
+	Lon                 float32
+	Date                time.Time
+	TempMin             float64
+	TempMax             float64
+	TempMorning         float64
+	TempAfternoon       float64
+	TempEvening         float64
+	TempNight           float64
+	HumidityAfternoon   float64
+	CloudCoverAfternoon float64
+	PressureAfternoon   float64
+	Precipitation       float64
+	WindMaxSpeed        float64
+	WindMaxDirection    float64
+	Units               units.System
+}
+
+// HistoricalRange is a sequence of day summaries together with totals over the whole range.
+type HistoricalRange struct {
+	Lat                float32
+	Lon                float32
+	From               time.Time
+	To                 time.Time
+	Days               []DaySummary
+	TempMin            float64
+	TempMax            float64
+	TempMean           float64
+	TotalPrecipitation float64
+	MaxWindSpeed       float64
+	Units              units.System
+}
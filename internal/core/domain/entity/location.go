@@ -0,0 +1,11 @@
+package entity
+
+// Location is a named place resolved by a geocoding provider.
+type Location struct {
+	Name       string
+	LocalNames map[string]string
+	Country    string
+	State      string
+	Lat        float32
+	Lon        float32
+}
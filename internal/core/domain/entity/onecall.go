@@ -0,0 +1,104 @@
+package entity
+
+import (
+	"strings"
+	"time"
+
+	"weather-api/pkg/language"
+	"weather-api/pkg/units"
+)
+
+// OneCallBlock names a section of the One Call payload that callers can opt into.
+type OneCallBlock string
+
+const (
+	OneCallCurrent  OneCallBlock = "current"
+	OneCallMinutely OneCallBlock = "minutely"
+	OneCallHourly   OneCallBlock = "hourly"
+	OneCallDaily    OneCallBlock = "daily"
+	OneCallAlerts   OneCallBlock = "alerts"
+)
+
+// AllOneCallBlocks returns every One Call block in payload order.
+func AllOneCallBlocks() []OneCallBlock {
+	return []OneCallBlock{OneCallCurrent, OneCallMinutely, OneCallHourly, OneCallDaily, OneCallAlerts}
+}
+
+// ParseOneCallBlock converts a case-insensitive block name into a OneCallBlock.
+func ParseOneCallBlock(name string) (OneCallBlock, bool) {
+	candidate := OneCallBlock(strings.ToLower(strings.TrimSpace(name)))
+	for _, block := range AllOneCallBlocks() {
+		if block == candidate {
+			return block, true
+		}
+	}
+	return "", false
+}
+
+// OneCall aggregates the One Call blocks for a coordinate.
+// Blocks that were not requested are left nil.
+type OneCall struct {
+	Lat            float32
+	Lon            float32
+	Timezone       string
+	TimezoneOffset int
+	Current        *CurrentConditions
+	Minutely       []MinutelyPrecipitation
+	Hourly         []HourlyConditions
+	Daily          []DailyConditions
+	Alerts         []WeatherAlert
+	Units          units.System
+	Language       language.Code
+	Provider       string
+}
+
+// CurrentConditions describes the observed weather at the time of the request.
+type CurrentConditions struct {
+	Time        time.Time
+	Sunrise     time.Time
+	Sunset      time.Time
+	Temperature float64
+	FeelsLike   float64
+	Pressure    int
+	Humidity    int
+	DewPoint    float64
+	UVIndex     float64
+	Clouds      int
+	Visibility  int
+	WindSpeed   float64
+	WindDeg     int
+	Description string
+}
+
+// MinutelyPrecipitation is the expected precipitation volume (mm/h) for one minute.
+type MinutelyPrecipitation struct {
+	Time          time.Time
+	Precipitation float64
+}
+
+// HourlyConditions is the forecast for a single hour.
+type HourlyConditions struct {
+	Time                     time.Time
+	Temperature              float64
+	FeelsLike                float64
+	Humidity                 int
+	WindSpeed                float64
+	Description              string
+	PrecipitationProbability float64
+}
+
+// DailyConditions is the forecast for a single day.
+type DailyConditions struct {
+	Date                     time.Time
+	Summary                  string
+	TempMin                  float64
+	TempMax                  float64
+	TempDay                  float64
+	TempNight                float64
+	Humidity                 int
+	WindSpeed                float64
+	Description              string
+	PrecipitationProbability float64
+	Rain                     float64
+	UVIndex                  float64
+}
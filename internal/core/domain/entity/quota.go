@@ -0,0 +1,28 @@
+package entity
+
+import "time"
+
+// QuotaUsage reports the upstream calls counted against one budgeted endpoint in the current windows.
+// Rejected counts the calls refused because a budget was used up.
+type QuotaUsage struct {
+	Endpoint string
+	Minute   QuotaWindow
+	Day      QuotaWindow
+	Rejected uint64
+}
+
+// QuotaWindow holds the calls used in one fixed window and its budget; a zero Limit is unlimited.
+// ResetAt is when the window ends and Used starts over from zero.
+type QuotaWindow struct {
+	Used    int
+	Limit   int
+	ResetAt time.Time
+}
+
+// Remaining returns the calls left in the window, or -1 when it is unlimited.
+func (w QuotaWindow) Remaining() int {
+	if w.Limit <= 0 {
+		return -1
+	}
+	return max(w.Limit-w.Used, 0)
+}
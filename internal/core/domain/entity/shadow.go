@@ -0,0 +1,28 @@
+package entity
+
+// ShadowStats summarizes how a candidate provider's answers compare to the primary ones.
+type ShadowStats struct {
+	Candidate     string
+	SamplePercent float64
+	Operations    map[string]ShadowOperationStats
+}
+
+// ShadowOperationStats counts the shadow calls made for one repository operation.
+// Sampled calls are either dropped, failed on the candidate or compared; a compared
+// response is divergent when at least one field differs by more than its tolerance.
+type ShadowOperationStats struct {
+	Sampled         uint64
+	Dropped         uint64
+	CandidateErrors uint64
+	Compared        uint64
+	Divergent       uint64
+	Fields          map[string]ShadowFieldStats
+}
+
+// ShadowFieldStats holds the absolute differences observed for a single compared field.
+type ShadowFieldStats struct {
+	Compared    uint64
+	Diverged    uint64
+	MeanAbsDiff float64
+	MaxAbsDiff  float64
+}
@@ -1,6 +1,11 @@
 package entity
 
-import "time"
+import (
+	"time"
+
+	"weather-api/pkg/language"
+	"weather-api/pkg/units"
+)
 
 // Weather is the core domain model for weather information.
 // It is independent of any presentation or database-specific details.
@@ -11,6 +16,21 @@ type Weather struct {
 	Humidity    int
 	WindSpeed   float64
 	Timestamp   time.Time
+	Units       units.System
+	Language    language.Code
+	Provider    string
+	Consensus   *WeatherConsensus
+}
+
+// WeatherConsensus describes how a blended reading was produced from several providers.
+// Each spread is the difference between the highest and lowest value reported for that field.
+type WeatherConsensus struct {
+	Strategy          string
+	Providers         []string
+	FailedProviders   []string
+	TemperatureSpread float64
+	HumiditySpread    float64
+	WindSpeedSpread   float64
 }
 
 type WeatherOverview struct {
@@ -20,4 +40,5 @@ type WeatherOverview struct {
 	Date            string
 	Units           string
 	WeatherOverview string
+	Provider        string
 }
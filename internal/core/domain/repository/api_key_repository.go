@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"weather-api/internal/core/domain/entity"
+)
+
+// APIKeyRepository stores API keys. Keys are looked up by the hash of their secret; the secret itself
+// is never stored.
+type APIKeyRepository interface {
+	CreateAPIKey(ctx context.Context, key entity.APIKey) error
+	FindAPIKeyByHash(ctx context.Context, hash string) (*entity.APIKey, error)
+	ListAPIKeys(ctx context.Context) ([]entity.APIKey, error)
+	RevokeAPIKey(ctx context.Context, id string, at time.Time) (*entity.APIKey, error)
+}
+
+var (
+	ErrAPIKeyNotFound = errors.New("api key not found")
+	ErrAPIKeyExists   = errors.New("api key already exists")
+)
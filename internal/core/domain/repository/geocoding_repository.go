@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+
+	"weather-api/internal/core/domain/entity"
+)
+
+// GeocodingRepository resolves place names to coordinates and coordinates to place names.
+type GeocodingRepository interface {
+	SearchLocations(ctx context.Context, query string, limit int) ([]entity.Location, error)
+	ReverseGeocode(ctx context.Context, lat float32, lon float32, limit int) ([]entity.Location, error)
+}
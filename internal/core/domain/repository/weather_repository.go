@@ -1,13 +1,26 @@
 package repository
 
 import (
+	"context"
 	"errors"
+	"time"
+
 	"weather-api/internal/core/domain/entity"
+	"weather-api/pkg/language"
+	"weather-api/pkg/units"
 )
 
 type WeatherRepository interface {
-	GetWeatherByCity(city string) (*entity.Weather, error)
-	GetWeatherOverviewByLatLong(lon float32, lat float32) (*entity.WeatherOverview, error)
+	GetWeatherByCity(ctx context.Context, city string, lang language.Code) (*entity.Weather, error)
+	GetWeatherByCoordinates(ctx context.Context, lat float32, lon float32, lang language.Code) (*entity.Weather, error)
+	GetWeatherOverviewByLatLong(ctx context.Context, lon float32, lat float32, system units.System) (*entity.WeatherOverview, error)
+	GetForecastByCity(ctx context.Context, city string, lang language.Code) (*entity.Forecast, error)
+	GetOneCall(ctx context.Context, lat float32, lon float32, include []entity.OneCallBlock, lang language.Code) (*entity.OneCall, error)
+	GetAirQuality(ctx context.Context, lat float32, lon float32) (*entity.AirQuality, error)
+	GetAirQualityForecast(ctx context.Context, lat float32, lon float32) (*entity.AirQuality, error)
+	GetAirQualityHistory(ctx context.Context, lat float32, lon float32, start time.Time, end time.Time) (*entity.AirQuality, error)
+	GetHistoricalWeather(ctx context.Context, lat float32, lon float32, at time.Time, lang language.Code) (*entity.HistoricalWeather, error)
+	GetDaySummary(ctx context.Context, lat float32, lon float32, date time.Time) (*entity.DaySummary, error)
 }
 
 var (
@@ -0,0 +1,153 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"weather-api/internal/core/domain/entity"
+	"weather-api/internal/core/domain/repository"
+)
+
+const (
+	// APIKeySecretPrefix starts every generated secret so keys are easy to spot in configs and logs scans.
+	APIKeySecretPrefix = "wapi_"
+	// apiKeyDisplayLength is how much of the secret is kept in APIKey.Prefix.
+	apiKeyDisplayLength = len(APIKeySecretPrefix) + 6
+)
+
+var (
+	// ErrInvalidAPIKey is returned when a secret does not belong to any key.
+	ErrInvalidAPIKey = errors.New("invalid api key")
+	// ErrAPIKeyExpired is returned when a key is past its expiry.
+	ErrAPIKeyExpired = errors.New("api key expired")
+	// ErrAPIKeyRevoked is returned when a key has been revoked.
+	ErrAPIKeyRevoked = errors.New("api key revoked")
+	// ErrInvalidAPIKeyScope is returned when a key is created without scopes or with an unknown one.
+	ErrInvalidAPIKeyScope = errors.New("invalid api key scope")
+	// ErrInvalidAPIKeyExpiry is returned when a key is created with an expiry in the past.
+	ErrInvalidAPIKeyExpiry = errors.New("api key expiry must be in the future")
+)
+
+// APIKeyServiceInterface defines API key management and authentication.
+type APIKeyServiceInterface interface {
+	CreateAPIKey(ctx context.Context, name string, scopes []entity.APIKeyScope, expiresAt *time.Time) (*entity.APIKey, string, error)
+	ListAPIKeys(ctx context.Context) ([]entity.APIKey, error)
+	RevokeAPIKey(ctx context.Context, id string) (*entity.APIKey, error)
+	Authenticate(ctx context.Context, secret string) (*entity.APIKey, error)
+}
+
+// APIKeyService issues API keys and checks presented secrets against the stored hashes.
+type APIKeyService struct {
+	keyRepo repository.APIKeyRepository
+	now     func() time.Time
+}
+
+// NewAPIKeyService creates a new API key service.
+func NewAPIKeyService(keyRepo repository.APIKeyRepository) *APIKeyService {
+	return &APIKeyService{
+		keyRepo: keyRepo,
+		now:     time.Now,
+	}
+}
+
+// CreateAPIKey generates a key with the given scopes and optional expiry. The returned secret is the
+// only copy; it cannot be recovered later.
+func (s *APIKeyService) CreateAPIKey(ctx context.Context, name string, scopes []entity.APIKeyScope, expiresAt *time.Time) (*entity.APIKey, string, error) {
+	if len(scopes) == 0 {
+		return nil, "", ErrInvalidAPIKeyScope
+	}
+	for _, scope := range scopes {
+		if !scope.Valid() {
+			return nil, "", fmt.Errorf("%w: %s", ErrInvalidAPIKeyScope, scope)
+		}
+	}
+	now := s.now().UTC()
+	if expiresAt != nil && !expiresAt.After(now) {
+		return nil, "", ErrInvalidAPIKeyExpiry
+	}
+
+	id, err := randomToken(8, hex.EncodeToString)
+	if err != nil {
+		return nil, "", err
+	}
+	secret, err := randomToken(32, base64.RawURLEncoding.EncodeToString)
+	if err != nil {
+		return nil, "", err
+	}
+	secret = APIKeySecretPrefix + secret
+
+	key := entity.APIKey{
+		ID:        id,
+		Name:      name,
+		Prefix:    secret[:apiKeyDisplayLength],
+		Hash:      HashAPIKey(secret),
+		Scopes:    scopes,
+		CreatedAt: now,
+	}
+	if expiresAt != nil {
+		expiry := expiresAt.UTC()
+		key.ExpiresAt = &expiry
+	}
+
+	if err := s.keyRepo.CreateAPIKey(ctx, key); err != nil {
+		return nil, "", err
+	}
+	return &key, secret, nil
+}
+
+// ListAPIKeys returns every key, including expired and revoked ones.
+func (s *APIKeyService) ListAPIKeys(ctx context.Context) ([]entity.APIKey, error) {
+	return s.keyRepo.ListAPIKeys(ctx)
+}
+
+// RevokeAPIKey revokes the key with the given ID. Revoking an already revoked key keeps its original
+// revocation time.
+func (s *APIKeyService) RevokeAPIKey(ctx context.Context, id string) (*entity.APIKey, error) {
+	return s.keyRepo.RevokeAPIKey(ctx, id, s.now().UTC())
+}
+
+// Authenticate returns the key a secret belongs to if it is neither expired nor revoked.
+func (s *APIKeyService) Authenticate(ctx context.Context, secret string) (*entity.APIKey, error) {
+	if !strings.HasPrefix(secret, APIKeySecretPrefix) {
+		return nil, ErrInvalidAPIKey
+	}
+
+	key, err := s.keyRepo.FindAPIKeyByHash(ctx, HashAPIKey(secret))
+	if errors.Is(err, repository.ErrAPIKeyNotFound) {
+		return nil, ErrInvalidAPIKey
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case key.Revoked():
+		return nil, ErrAPIKeyRevoked
+	case key.Expired(s.now()):
+		return nil, ErrAPIKeyExpired
+	}
+	return key, nil
+}
+
+// HashAPIKey returns the hex SHA-256 digest under which a secret is stored. Secrets are 256 random bits,
+// so a fast unsalted hash is enough and keeps lookups by hash possible.
+func HashAPIKey(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+// randomToken encodes size bytes from crypto/rand.
+func randomToken(size int, encode func([]byte) string) (string, error) {
+	buf := make([]byte, size)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("generate api key: %w", err)
+	}
+	return encode(buf), nil
+}
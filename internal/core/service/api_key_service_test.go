@@ -0,0 +1,163 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"weather-api/internal/core/domain/entity"
+	"weather-api/internal/core/domain/repository"
+)
+
+// MockAPIKeyRepository is an in-memory key store for testing
+type MockAPIKeyRepository struct {
+	keys map[string]entity.APIKey
+}
+
+func (m *MockAPIKeyRepository) CreateAPIKey(ctx context.Context, key entity.APIKey) error {
+	if m.keys == nil {
+		m.keys = make(map[string]entity.APIKey)
+	}
+	m.keys[key.ID] = key
+	return nil
+}
+
+func (m *MockAPIKeyRepository) FindAPIKeyByHash(ctx context.Context, hash string) (*entity.APIKey, error) {
+	for _, key := range m.keys {
+		if key.Hash == hash {
+			return &key, nil
+		}
+	}
+	return nil, repository.ErrAPIKeyNotFound
+}
+
+func (m *MockAPIKeyRepository) ListAPIKeys(ctx context.Context) ([]entity.APIKey, error) {
+	keys := make([]entity.APIKey, 0, len(m.keys))
+	for _, key := range m.keys {
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+func (m *MockAPIKeyRepository) RevokeAPIKey(ctx context.Context, id string, at time.Time) (*entity.APIKey, error) {
+	key, ok := m.keys[id]
+	if !ok {
+		return nil, repository.ErrAPIKeyNotFound
+	}
+	if key.RevokedAt == nil {
+		key.RevokedAt = &at
+		m.keys[id] = key
+	}
+	return &key, nil
+}
+
+func TestAPIKeyService_CreateAndAuthenticate(t *testing.T) {
+	// Arrange
+	repo := &MockAPIKeyRepository{}
+	service := NewAPIKeyService(repo)
+
+	// Act
+	key, secret, err := service.CreateAPIKey(context.Background(), "nightly-job", []entity.APIKeyScope{entity.ScopeWeatherRead}, nil)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !strings.HasPrefix(secret, APIKeySecretPrefix) || !strings.HasPrefix(secret, key.Prefix) {
+		t.Errorf("Expected secret %q to start with %q and the key prefix %q", secret, APIKeySecretPrefix, key.Prefix)
+	}
+	if stored := repo.keys[key.ID]; stored.Hash == secret || stored.Hash != HashAPIKey(secret) {
+		t.Errorf("Expected only the hash of the secret to be stored, got %q", stored.Hash)
+	}
+
+	authenticated, err := service.Authenticate(context.Background(), secret)
+	if err != nil {
+		t.Fatalf("Expected secret to authenticate, got %v", err)
+	}
+	if authenticated.ID != key.ID || !authenticated.HasScope(entity.ScopeWeatherRead) || authenticated.HasScope(entity.ScopeAdmin) {
+		t.Errorf("Unexpected authenticated key %+v", authenticated)
+	}
+}
+
+func TestAPIKeyService_Authenticate_Rejected(t *testing.T) {
+	// Arrange
+	repo := &MockAPIKeyRepository{}
+	service := NewAPIKeyService(repo)
+	now := time.Date(2024, 1, 10, 12, 0, 0, 0, time.UTC)
+	service.now = func() time.Time { return now }
+
+	expiry := now.Add(time.Hour)
+	_, expiring, _ := service.CreateAPIKey(context.Background(), "expiring", []entity.APIKeyScope{entity.ScopeWeatherRead}, &expiry)
+	revokedKey, revoked, _ := service.CreateAPIKey(context.Background(), "revoked", []entity.APIKeyScope{entity.ScopeWeatherRead}, nil)
+	if _, err := service.RevokeAPIKey(context.Background(), revokedKey.ID); err != nil {
+		t.Fatalf("Expected revoke to succeed, got %v", err)
+	}
+	now = now.Add(2 * time.Hour)
+
+	tests := []struct {
+		name   string
+		secret string
+		want   error
+	}{
+		{"unknown", APIKeySecretPrefix + "does-not-exist", ErrInvalidAPIKey},
+		{"wrong prefix", "not-a-key", ErrInvalidAPIKey},
+		{"expired", expiring, ErrAPIKeyExpired},
+		{"revoked", revoked, ErrAPIKeyRevoked},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			key, err := service.Authenticate(context.Background(), tt.secret)
+
+			// Assert
+			if !errors.Is(err, tt.want) || key != nil {
+				t.Errorf("Expected %v, got key %v and error %v", tt.want, key, err)
+			}
+		})
+	}
+}
+
+func TestAPIKeyService_CreateAPIKey_Invalid(t *testing.T) {
+	// Arrange
+	service := NewAPIKeyService(&MockAPIKeyRepository{})
+	past := time.Now().Add(-time.Minute)
+
+	tests := []struct {
+		name      string
+		scopes    []entity.APIKeyScope
+		expiresAt *time.Time
+		want      error
+	}{
+		{"no scopes", nil, nil, ErrInvalidAPIKeyScope},
+		{"unknown scope", []entity.APIKeyScope{"weather:write"}, nil, ErrInvalidAPIKeyScope},
+		{"expiry in the past", []entity.APIKeyScope{entity.ScopeAdmin}, &past, ErrInvalidAPIKeyExpiry},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			_, _, err := service.CreateAPIKey(context.Background(), "key", tt.scopes, tt.expiresAt)
+
+			// Assert
+			if !errors.Is(err, tt.want) {
+				t.Errorf("Expected %v, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestAPIKeyService_RevokeAPIKey_NotFound(t *testing.T) {
+	// Arrange
+	service := NewAPIKeyService(&MockAPIKeyRepository{})
+
+	// Act
+	_, err := service.RevokeAPIKey(context.Background(), "missing")
+
+	// Assert
+	if !errors.Is(err, repository.ErrAPIKeyNotFound) {
+		t.Errorf("Expected ErrAPIKeyNotFound, got %v", err)
+	}
+}
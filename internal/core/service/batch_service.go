@@ -0,0 +1,126 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"weather-api/internal/core/domain/entity"
+	"weather-api/pkg/language"
+	"weather-api/pkg/units"
+)
+
+// ErrInvalidBatchSize is returned when a batch is empty or longer than the configured maximum.
+var ErrInvalidBatchSize = errors.New("invalid batch size")
+
+// BatchServiceInterface defines the batch weather use case.
+type BatchServiceInterface interface {
+	GetWeatherBatch(ctx context.Context, queries []entity.BatchQuery, system units.System, lang language.Code) ([]entity.BatchResult, error)
+	StreamWeatherBatch(ctx context.Context, queries []entity.BatchQuery, system units.System, lang language.Code) (<-chan entity.BatchResult, error)
+	MaxItems() int
+	MaxStreamItems() int
+}
+
+// BatchService looks up the current weather for many locations at once on top of the weather service,
+// so every item goes through the same caching, conversion and error handling as a single lookup.
+type BatchService struct {
+	weatherService WeatherServiceInterface
+	maxItems       int
+	maxStreamItems int
+	concurrency    int
+}
+
+// NewBatchService creates a batch service accepting up to maxItems queries per buffered batch and
+// maxStreamItems per streamed batch, fetching at most concurrency of them at a time.
+func NewBatchService(weatherService WeatherServiceInterface, maxItems int, maxStreamItems int, concurrency int) *BatchService {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	return &BatchService{
+		weatherService: weatherService,
+		maxItems:       maxItems,
+		maxStreamItems: maxStreamItems,
+		concurrency:    concurrency,
+	}
+}
+
+// MaxItems returns the largest number of queries accepted in one buffered batch.
+func (s *BatchService) MaxItems() int {
+	return s.maxItems
+}
+
+// MaxStreamItems returns the largest number of queries accepted in one streamed batch.
+func (s *BatchService) MaxStreamItems() int {
+	return s.maxStreamItems
+}
+
+// GetWeatherBatch fetches the current weather for every query with a bounded pool of workers.
+// Results keep the order of queries; a failed query is reported in its result and does not affect
+// the others. Queries not yet started when ctx ends fail with the context error.
+func (s *BatchService) GetWeatherBatch(ctx context.Context, queries []entity.BatchQuery, system units.System, lang language.Code) ([]entity.BatchResult, error) {
+	if len(queries) == 0 || len(queries) > s.maxItems {
+		return nil, ErrInvalidBatchSize
+	}
+
+	results := make([]entity.BatchResult, len(queries))
+	for result := range s.run(ctx, queries, system, lang) {
+		results[result.Index] = result
+	}
+	return results, nil
+}
+
+// StreamWeatherBatch fetches the current weather for every query like GetWeatherBatch but delivers
+// each result as soon as it completes, so results arrive in completion order. The channel receives
+// exactly one result per query and is closed afterwards; callers must drain it. Once ctx ends the
+// remaining queries fail immediately with the context error instead of reaching the provider.
+func (s *BatchService) StreamWeatherBatch(ctx context.Context, queries []entity.BatchQuery, system units.System, lang language.Code) (<-chan entity.BatchResult, error) {
+	if len(queries) == 0 || len(queries) > s.maxStreamItems {
+		return nil, ErrInvalidBatchSize
+	}
+	return s.run(ctx, queries, system, lang), nil
+}
+
+// run looks up queries on min(concurrency, len(queries)) workers and sends every result on the
+// returned channel, closing it when all of them are done.
+func (s *BatchService) run(ctx context.Context, queries []entity.BatchQuery, system units.System, lang language.Code) <-chan entity.BatchResult {
+	workers := min(s.concurrency, len(queries))
+	jobs := make(chan int)
+	results := make(chan entity.BatchResult, workers)
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for index := range jobs {
+				results <- s.lookup(ctx, index, queries[index], system, lang)
+			}
+		}()
+	}
+
+	go func() {
+		for index := range queries {
+			jobs <- index
+		}
+		close(jobs)
+		wg.Wait()
+		close(results)
+	}()
+
+	return results
+}
+
+func (s *BatchService) lookup(ctx context.Context, index int, query entity.BatchQuery, system units.System, lang language.Code) entity.BatchResult {
+	result := entity.BatchResult{Index: index, Query: query}
+	if err := ctx.Err(); err != nil {
+		result.Err = err
+		return result
+	}
+
+	if query.City != "" {
+		result.Weather, result.Err = s.weatherService.GetWeatherByCity(ctx, query.City, system, lang)
+	} else {
+		result.Weather, result.Err = s.weatherService.GetWeatherByCoordinates(ctx, query.Lat, query.Lon, system, lang)
+	}
+	return result
+}
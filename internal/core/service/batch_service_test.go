@@ -0,0 +1,251 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"weather-api/internal/core/domain/entity"
+	"weather-api/internal/core/domain/repository"
+	"weather-api/pkg/language"
+	"weather-api/pkg/units"
+)
+
+// stubWeatherService overrides the lookups used by batches; other methods are not called.
+type stubWeatherService struct {
+	WeatherServiceInterface
+
+	byCity        func(city string) (*entity.Weather, error)
+	byCoordinates func(lat float32, lon float32) (*entity.Weather, error)
+}
+
+func (s *stubWeatherService) GetWeatherByCity(ctx context.Context, city string, system units.System, lang language.Code) (*entity.Weather, error) {
+	return s.byCity(city)
+}
+
+func (s *stubWeatherService) GetWeatherByCoordinates(ctx context.Context, lat float32, lon float32, system units.System, lang language.Code) (*entity.Weather, error) {
+	return s.byCoordinates(lat, lon)
+}
+
+func TestBatchService_GetWeatherBatch_KeepsOrderAndItemErrors(t *testing.T) {
+	// Arrange
+	weatherService := &stubWeatherService{
+		byCity: func(city string) (*entity.Weather, error) {
+			if city == "Atlantis" {
+				return nil, repository.ErrCityNotFound
+			}
+			return &entity.Weather{City: city}, nil
+		},
+		byCoordinates: func(lat float32, lon float32) (*entity.Weather, error) {
+			return &entity.Weather{Temperature: float64(lat)}, nil
+		},
+	}
+	service := NewBatchService(weatherService, 10, 10, 2)
+	queries := []entity.BatchQuery{{City: "London"}, {City: "Atlantis"}, {Lat: 38.5, Lon: 27.1}, {City: "Paris"}}
+
+	// Act
+	results, err := service.GetWeatherBatch(context.Background(), queries, units.Metric, language.English)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(results) != len(queries) {
+		t.Fatalf("Expected %d results, got %d", len(queries), len(results))
+	}
+	if results[0].Weather.City != "London" || results[3].Weather.City != "Paris" {
+		t.Errorf("Expected results in query order, got %+v", results)
+	}
+	if !errors.Is(results[1].Err, repository.ErrCityNotFound) || results[1].Weather != nil {
+		t.Errorf("Expected ErrCityNotFound for Atlantis, got %+v", results[1])
+	}
+	if results[2].Err != nil || results[2].Weather.Temperature != 38.5 {
+		t.Errorf("Expected coordinate lookup, got %+v", results[2])
+	}
+	if results[2].Query != queries[2] {
+		t.Errorf("Expected query to be echoed, got %+v", results[2].Query)
+	}
+}
+
+func TestBatchService_GetWeatherBatch_BoundsConcurrency(t *testing.T) {
+	// Arrange
+	var inFlight, peak atomic.Int64
+	weatherService := &stubWeatherService{
+		byCity: func(city string) (*entity.Weather, error) {
+			current := inFlight.Add(1)
+			defer inFlight.Add(-1)
+			for {
+				observed := peak.Load()
+				if current <= observed || peak.CompareAndSwap(observed, current) {
+					break
+				}
+			}
+			time.Sleep(10 * time.Millisecond)
+			return &entity.Weather{City: city}, nil
+		},
+	}
+	service := NewBatchService(weatherService, 20, 20, 3)
+	queries := make([]entity.BatchQuery, 12)
+	for i := range queries {
+		queries[i] = entity.BatchQuery{City: "London"}
+	}
+
+	// Act
+	results, err := service.GetWeatherBatch(context.Background(), queries, units.Metric, language.English)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(results) != 12 {
+		t.Fatalf("Expected 12 results, got %d", len(results))
+	}
+	if peak.Load() > 3 {
+		t.Errorf("Expected at most 3 concurrent lookups, got %d", peak.Load())
+	}
+}
+
+func TestBatchService_GetWeatherBatch_CanceledContext(t *testing.T) {
+	// Arrange
+	var calls atomic.Int64
+	weatherService := &stubWeatherService{
+		byCity: func(city string) (*entity.Weather, error) {
+			calls.Add(1)
+			return &entity.Weather{City: city}, nil
+		},
+	}
+	service := NewBatchService(weatherService, 10, 10, 2)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// Act
+	results, err := service.GetWeatherBatch(ctx, []entity.BatchQuery{{City: "London"}, {City: "Paris"}}, units.Metric, language.English)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	for _, result := range results {
+		if !errors.Is(result.Err, context.Canceled) {
+			t.Errorf("Expected context.Canceled, got %v", result.Err)
+		}
+	}
+	if calls.Load() != 0 {
+		t.Errorf("Expected no lookups after cancellation, got %d", calls.Load())
+	}
+}
+
+func TestBatchService_GetWeatherBatch_InvalidSize(t *testing.T) {
+	// Arrange
+	service := NewBatchService(&stubWeatherService{}, 2, 2, 2)
+
+	tests := []struct {
+		name    string
+		queries []entity.BatchQuery
+	}{
+		{"empty", nil},
+		{"too many", []entity.BatchQuery{{City: "A"}, {City: "B"}, {City: "C"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			_, err := service.GetWeatherBatch(context.Background(), tt.queries, units.Metric, language.English)
+
+			// Assert
+			if !errors.Is(err, ErrInvalidBatchSize) {
+				t.Errorf("Expected ErrInvalidBatchSize, got %v", err)
+			}
+		})
+	}
+}
+
+func TestBatchService_StreamWeatherBatch_CompletionOrder(t *testing.T) {
+	// Arrange
+	release := make(chan struct{})
+	weatherService := &stubWeatherService{
+		byCity: func(city string) (*entity.Weather, error) {
+			if city == "London" {
+				<-release
+			}
+			return &entity.Weather{City: city}, nil
+		},
+	}
+	service := NewBatchService(weatherService, 1, 10, 2)
+
+	// Act
+	stream, err := service.StreamWeatherBatch(context.Background(), []entity.BatchQuery{{City: "London"}, {City: "Paris"}, {City: "Rome"}}, units.Metric, language.English)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	var order []int
+	for result := range stream {
+		if result.Weather.City != []string{"London", "Paris", "Rome"}[result.Index] {
+			t.Errorf("Expected index %d to match its query, got %+v", result.Index, result)
+		}
+		order = append(order, result.Index)
+		if len(order) == 2 {
+			close(release)
+		}
+	}
+	if len(order) != 3 || order[2] != 0 {
+		t.Errorf("Expected the blocked first query to arrive last, got order %v", order)
+	}
+}
+
+func TestBatchService_StreamWeatherBatch_StopsAfterCancel(t *testing.T) {
+	// Arrange
+	var calls atomic.Int64
+	weatherService := &stubWeatherService{
+		byCity: func(city string) (*entity.Weather, error) {
+			calls.Add(1)
+			return &entity.Weather{City: city}, nil
+		},
+	}
+	service := NewBatchService(weatherService, 1, 100, 1)
+	queries := make([]entity.BatchQuery, 100)
+	for i := range queries {
+		queries[i] = entity.BatchQuery{City: "London"}
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+
+	// Act
+	stream, err := service.StreamWeatherBatch(ctx, queries, units.Metric, language.English)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	<-stream
+	cancel()
+	received, canceled := 1, 0
+	for result := range stream {
+		received++
+		if errors.Is(result.Err, context.Canceled) {
+			canceled++
+		}
+	}
+
+	// Assert
+	if received != len(queries) {
+		t.Errorf("Expected one result per query, got %d", received)
+	}
+	if canceled == 0 || calls.Load()+int64(canceled) != int64(len(queries)) {
+		t.Errorf("Expected remaining queries to be canceled, got %d lookups and %d canceled", calls.Load(), canceled)
+	}
+}
+
+func TestBatchService_StreamWeatherBatch_InvalidSize(t *testing.T) {
+	// Arrange
+	service := NewBatchService(&stubWeatherService{}, 1, 2, 2)
+
+	// Act
+	_, err := service.StreamWeatherBatch(context.Background(), []entity.BatchQuery{{City: "A"}, {City: "B"}, {City: "C"}}, units.Metric, language.English)
+
+	// Assert
+	if !errors.Is(err, ErrInvalidBatchSize) {
+		t.Errorf("Expected ErrInvalidBatchSize, got %v", err)
+	}
+}
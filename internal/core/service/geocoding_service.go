@@ -0,0 +1,64 @@
+package service
+
+import (
+	"context"
+	"weather-api/internal/core/domain/entity"
+	"weather-api/internal/core/domain/repository"
+)
+
+const (
+	// DefaultGeocodingLimit is used when callers do not ask for a specific number of matches.
+	DefaultGeocodingLimit = 5
+	// MaxGeocodingLimit is the largest number of matches a single lookup may return.
+	MaxGeocodingLimit = 5
+)
+
+// GeocodingServiceInterface defines the core geocoding use cases.
+type GeocodingServiceInterface interface {
+	SearchLocations(ctx context.Context, query string, limit int) ([]entity.Location, error)
+	ReverseGeocode(ctx context.Context, lat float32, lon float32, limit int) ([]entity.Location, error)
+}
+
+// GeocodingService resolves place names and coordinates so clients can
+// disambiguate locations before asking for weather.
+type GeocodingService struct {
+	geocodingRepo repository.GeocodingRepository
+}
+
+// NewGeocodingService creates a new geocoding service.
+func NewGeocodingService(geocodingRepo repository.GeocodingRepository) *GeocodingService {
+	return &GeocodingService{
+		geocodingRepo: geocodingRepo,
+	}
+}
+
+// SearchLocations returns the places matching a free-form query such as "Paris, FR".
+func (s *GeocodingService) SearchLocations(ctx context.Context, query string, limit int) ([]entity.Location, error) {
+	locations, err := s.geocodingRepo.SearchLocations(ctx, query, clampGeocodingLimit(limit))
+	if err != nil {
+		return nil, err
+	}
+
+	return locations, nil
+}
+
+// ReverseGeocode returns the places closest to a coordinate.
+func (s *GeocodingService) ReverseGeocode(ctx context.Context, lat float32, lon float32, limit int) ([]entity.Location, error) {
+	locations, err := s.geocodingRepo.ReverseGeocode(ctx, lat, lon, clampGeocodingLimit(limit))
+	if err != nil {
+		return nil, err
+	}
+
+	return locations, nil
+}
+
+// clampGeocodingLimit keeps limit within [1, MaxGeocodingLimit], applying the default when unset.
+func clampGeocodingLimit(limit int) int {
+	if limit <= 0 {
+		return DefaultGeocodingLimit
+	}
+	if limit > MaxGeocodingLimit {
+		return MaxGeocodingLimit
+	}
+	return limit
+}
@@ -0,0 +1,101 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"weather-api/internal/core/domain/entity"
+	"weather-api/internal/core/domain/repository"
+)
+
+// MockGeocodingRepository is a mock implementation for testing
+type MockGeocodingRepository struct {
+	locations []entity.Location
+	err       error
+
+	lastLimit int
+}
+
+func (m *MockGeocodingRepository) SearchLocations(ctx context.Context, query string, limit int) ([]entity.Location, error) {
+	m.lastLimit = limit
+	return m.locations, m.err
+}
+
+func (m *MockGeocodingRepository) ReverseGeocode(ctx context.Context, lat float32, lon float32, limit int) ([]entity.Location, error) {
+	m.lastLimit = limit
+	return m.locations, m.err
+}
+
+func TestGeocodingService_SearchLocations_Success(t *testing.T) {
+	// Arrange
+	mockRepo := &MockGeocodingRepository{
+		locations: []entity.Location{
+			{Name: "Paris", Country: "FR", Lat: 48.85, Lon: 2.35},
+			{Name: "Paris", Country: "US", State: "Texas", Lat: 33.66, Lon: -95.55},
+		},
+	}
+
+	service := NewGeocodingService(mockRepo)
+
+	// Act
+	locations, err := service.SearchLocations(context.Background(), "Paris", 2)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(locations) != 2 {
+		t.Fatalf("Expected 2 locations, got %d", len(locations))
+	}
+	if locations[1].State != "Texas" {
+		t.Errorf("Expected second match in Texas, got %s", locations[1].State)
+	}
+	if mockRepo.lastLimit != 2 {
+		t.Errorf("Expected limit=2, got %d", mockRepo.lastLimit)
+	}
+}
+
+func TestGeocodingService_ClampsLimit(t *testing.T) {
+	tests := []struct {
+		name  string
+		limit int
+		want  int
+	}{
+		{name: "unset uses default", limit: 0, want: DefaultGeocodingLimit},
+		{name: "negative uses default", limit: -3, want: DefaultGeocodingLimit},
+		{name: "above max is capped", limit: 50, want: MaxGeocodingLimit},
+		{name: "within range is kept", limit: 3, want: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := &MockGeocodingRepository{}
+			service := NewGeocodingService(mockRepo)
+
+			_, _ = service.ReverseGeocode(context.Background(), 48.85, 2.35, tt.limit)
+
+			if mockRepo.lastLimit != tt.want {
+				t.Errorf("Expected limit=%d, got %d", tt.want, mockRepo.lastLimit)
+			}
+		})
+	}
+}
+
+func TestGeocodingService_ReverseGeocode_Error(t *testing.T) {
+	// Arrange
+	mockRepo := &MockGeocodingRepository{err: repository.ErrAPIError}
+
+	service := NewGeocodingService(mockRepo)
+
+	// Act
+	locations, err := service.ReverseGeocode(context.Background(), 48.85, 2.35, 1)
+
+	// Assert
+	if !errors.Is(err, repository.ErrAPIError) {
+		t.Errorf("Expected ErrAPIError, got %v", err)
+	}
+	if locations != nil {
+		t.Error("Expected nil locations on error")
+	}
+}
@@ -0,0 +1,121 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"weather-api/internal/core/domain/entity"
+)
+
+// HealthCheck is one pluggable readiness check; Check returns nil when the dependency is usable.
+// When CacheTTL is set, a result is reused for that long, so checks that cost upstream calls cannot
+// spend quota on every probe of the readiness endpoint. An Optional check that fails is reported as
+// degraded and does not make the service unready.
+type HealthCheck struct {
+	Name     string
+	Check    func(ctx context.Context) error
+	CacheTTL time.Duration
+	Optional bool
+}
+
+// HealthServiceInterface defines the readiness use case.
+type HealthServiceInterface interface {
+	Readiness(ctx context.Context) entity.HealthReport
+}
+
+// HealthService runs the registered readiness checks.
+type HealthService struct {
+	checks  []*healthCheckState
+	timeout time.Duration
+	now     func() time.Time
+}
+
+// healthCheckState holds a check's last result. mu is held while the check runs, so concurrent
+// readiness probes wait for one run and share its result instead of starting their own.
+type healthCheckState struct {
+	HealthCheck
+
+	mu   sync.Mutex
+	last *entity.HealthCheckResult
+}
+
+// NewHealthService creates a health service running checks, each bounded by timeout (0 for none).
+func NewHealthService(timeout time.Duration, checks ...HealthCheck) *HealthService {
+	states := make([]*healthCheckState, 0, len(checks))
+	for _, check := range checks {
+		states = append(states, &healthCheckState{HealthCheck: check})
+	}
+
+	return &HealthService{
+		checks:  states,
+		timeout: timeout,
+		now:     time.Now,
+	}
+}
+
+// Readiness runs every check concurrently and reports whether none of them is down. Checks run detached
+// from ctx's cancellation, so a caller hanging up cannot leave a canceled result in the cache.
+func (s *HealthService) Readiness(ctx context.Context) entity.HealthReport {
+	results := make([]entity.HealthCheckResult, len(s.checks))
+
+	var wg sync.WaitGroup
+	for i, check := range s.checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = s.run(context.WithoutCancel(ctx), check)
+		}()
+	}
+	wg.Wait()
+
+	report := entity.HealthReport{Ready: true, Checks: results}
+	for _, result := range results {
+		switch result.Status {
+		case entity.HealthStatusDown:
+			report.Ready = false
+		case entity.HealthStatusDegraded:
+			report.Degraded = true
+		}
+	}
+	report.Degraded = report.Degraded && report.Ready
+	return report
+}
+
+// run returns check's cached result while it is fresh, and otherwise runs the check and stores the result.
+func (s *HealthService) run(ctx context.Context, check *healthCheckState) entity.HealthCheckResult {
+	check.mu.Lock()
+	defer check.mu.Unlock()
+
+	if check.last != nil && s.now().Sub(check.last.CheckedAt) < check.CacheTTL {
+		cached := *check.last
+		cached.Cached = true
+		return cached
+	}
+
+	if s.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.timeout)
+		defer cancel()
+	}
+
+	checkedAt := s.now()
+	start := time.Now()
+	err := check.Check(ctx)
+	result := entity.HealthCheckResult{
+		Name:      check.Name,
+		Status:    entity.HealthStatusUp,
+		Latency:   time.Since(start),
+		CheckedAt: checkedAt,
+	}
+	if err != nil {
+		result.Status = entity.HealthStatusDown
+		if check.Optional {
+			result.Status = entity.HealthStatusDegraded
+		}
+		result.Error = err.Error()
+	}
+
+	check.last = &result
+	return result
+}
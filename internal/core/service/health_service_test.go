@@ -0,0 +1,108 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"weather-api/internal/core/domain/entity"
+)
+
+func TestHealthService_Readiness(t *testing.T) {
+	// Arrange
+	service := NewHealthService(time.Second,
+		HealthCheck{Name: "upstream", Check: func(ctx context.Context) error { return nil }},
+		HealthCheck{Name: "breaker", Check: func(ctx context.Context) error { return errors.New("circuit breaker is open") }},
+	)
+
+	// Act
+	report := service.Readiness(context.Background())
+
+	// Assert
+	if report.Ready {
+		t.Error("Expected not ready while a check is down")
+	}
+	if len(report.Checks) != 2 || report.Checks[0].Name != "upstream" || report.Checks[1].Name != "breaker" {
+		t.Fatalf("Expected checks in registration order, got %+v", report.Checks)
+	}
+	if report.Checks[0].Status != entity.HealthStatusUp || report.Checks[0].Error != "" {
+		t.Errorf("Expected upstream up, got %+v", report.Checks[0])
+	}
+	if report.Checks[1].Status != entity.HealthStatusDown || report.Checks[1].Error != "circuit breaker is open" {
+		t.Errorf("Expected breaker down, got %+v", report.Checks[1])
+	}
+}
+
+func TestHealthService_Readiness_CachesResults(t *testing.T) {
+	// Arrange
+	var probes atomic.Int64
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	service := NewHealthService(time.Second,
+		HealthCheck{Name: "upstream", CacheTTL: time.Minute, Check: func(ctx context.Context) error {
+			probes.Add(1)
+			return nil
+		}},
+	)
+	service.now = func() time.Time { return now }
+
+	// Act
+	first := service.Readiness(context.Background())
+	cached := service.Readiness(context.Background())
+	now = now.Add(time.Minute)
+	refreshed := service.Readiness(context.Background())
+
+	// Assert
+	if probes.Load() != 2 {
+		t.Errorf("Expected 2 probes, got %d", probes.Load())
+	}
+	if first.Checks[0].Cached || !cached.Checks[0].Cached || refreshed.Checks[0].Cached {
+		t.Errorf("Expected only the second result to be cached, got %v, %v, %v", first.Checks[0].Cached, cached.Checks[0].Cached, refreshed.Checks[0].Cached)
+	}
+	if !cached.Checks[0].CheckedAt.Equal(first.Checks[0].CheckedAt) {
+		t.Errorf("Expected the cached result to keep its check time, got %v", cached.Checks[0].CheckedAt)
+	}
+}
+
+func TestHealthService_Readiness_TimesOutAndIgnoresCallerCancellation(t *testing.T) {
+	// Arrange
+	service := NewHealthService(10*time.Millisecond,
+		HealthCheck{Name: "upstream", Check: func(ctx context.Context) error {
+			<-ctx.Done()
+			return ctx.Err()
+		}},
+	)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// Act
+	report := service.Readiness(ctx)
+
+	// Assert
+	if report.Checks[0].Error != context.DeadlineExceeded.Error() {
+		t.Errorf("Expected the check to run until its own timeout, got %q", report.Checks[0].Error)
+	}
+	if report.Checks[0].Latency < 10*time.Millisecond {
+		t.Errorf("Expected latency of at least the timeout, got %v", report.Checks[0].Latency)
+	}
+}
+
+func TestHealthService_Readiness_OptionalCheckDegrades(t *testing.T) {
+	// Arrange
+	service := NewHealthService(time.Second,
+		HealthCheck{Name: "upstream", Check: func(ctx context.Context) error { return nil }},
+		HealthCheck{Name: "breaker", Optional: true, Check: func(ctx context.Context) error { return errors.New("circuit breaker is open") }},
+	)
+
+	// Act
+	report := service.Readiness(context.Background())
+
+	// Assert
+	if !report.Ready || !report.Degraded {
+		t.Errorf("Expected ready and degraded, got ready=%v degraded=%v", report.Ready, report.Degraded)
+	}
+	if report.Checks[1].Status != entity.HealthStatusDegraded || report.Checks[1].Error != "circuit breaker is open" {
+		t.Errorf("Expected breaker degraded, got %+v", report.Checks[1])
+	}
+}
@@ -0,0 +1,108 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"weather-api/internal/core/domain/entity"
+	"weather-api/pkg/language"
+)
+
+// GetAlerts retrieves the agency alerts for a coordinate with a normalized severity,
+// keeping only alerts at or above minSeverity and, when activeAt is non-zero, alerts in effect at that time.
+// Alerts are ordered from most to least severe, then by start time.
+func (s *WeatherService) GetAlerts(ctx context.Context, lat float32, lon float32, minSeverity entity.AlertSeverity, activeAt time.Time) ([]entity.WeatherAlert, error) {
+	oneCall, err := s.weatherRepo.GetOneCall(ctx, lat, lon, []entity.OneCallBlock{entity.OneCallAlerts}, language.English)
+	if err != nil {
+		return nil, err
+	}
+
+	alerts := make([]entity.WeatherAlert, 0, len(oneCall.Alerts))
+	for _, alert := range withSeverity(oneCall.Alerts) {
+		if alert.Severity < minSeverity {
+			continue
+		}
+		if !activeAt.IsZero() && !alert.IsActiveAt(activeAt) {
+			continue
+		}
+		alerts = append(alerts, alert)
+	}
+
+	sort.SliceStable(alerts, func(i, j int) bool {
+		if alerts[i].Severity != alerts[j].Severity {
+			return alerts[i].Severity > alerts[j].Severity
+		}
+		return alerts[i].Start.Before(alerts[j].Start)
+	})
+
+	return alerts, nil
+}
+
+// withSeverity returns a copy of alerts with Severity populated.
+func withSeverity(alerts []entity.WeatherAlert) []entity.WeatherAlert {
+	if alerts == nil {
+		return nil
+	}
+
+	classified := make([]entity.WeatherAlert, len(alerts))
+	for i, alert := range alerts {
+		alert.Severity = classifyAlertSeverity(alert)
+		classified[i] = alert
+	}
+	return classified
+}
+
+// severityRule maps phrases found in an alert's event or tags to a severity.
+// Rules are evaluated in order, so the most severe phrases come first.
+type severityRule struct {
+	severity entity.AlertSeverity
+	phrases  []string
+}
+
+var severityRules = []severityRule{
+	{
+		severity: entity.AlertSeverityExtreme,
+		phrases: []string{
+			"extreme", "red", "emergency", "tornado warning", "hurricane warning",
+			"typhoon warning", "tsunami warning", "extreme wind warning",
+		},
+	},
+	{
+		severity: entity.AlertSeveritySevere,
+		phrases:  []string{"warning", "orange", "amber", "severe"},
+	},
+	{
+		severity: entity.AlertSeverityModerate,
+		phrases:  []string{"watch", "advisory", "yellow", "moderate"},
+	},
+	{
+		severity: entity.AlertSeverityMinor,
+		phrases:  []string{"statement", "outlook", "green", "minor", "information", "notice"},
+	},
+}
+
+// classifyAlertSeverity normalizes the free-form event and tags of an agency alert into a severity level.
+// Matching is done on whole words so that e.g. "reduced visibility" is not read as a red alert.
+func classifyAlertSeverity(alert entity.WeatherAlert) entity.AlertSeverity {
+	text := normalizeAlertText(alert.Event + " " + strings.Join(alert.Tags, " "))
+
+	for _, rule := range severityRules {
+		for _, phrase := range rule.phrases {
+			if strings.Contains(text, " "+phrase+" ") {
+				return rule.severity
+			}
+		}
+	}
+	return entity.AlertSeverityUnknown
+}
+
+// normalizeAlertText lowercases text and reduces it to space separated words with padding spaces.
+func normalizeAlertText(text string) string {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r)
+	})
+	return " " + strings.Join(words, " ") + " "
+}
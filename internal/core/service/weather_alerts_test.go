@@ -0,0 +1,101 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"weather-api/internal/core/domain/entity"
+)
+
+func TestClassifyAlertSeverity(t *testing.T) {
+	tests := []struct {
+		event string
+		tags  []string
+		want  entity.AlertSeverity
+	}{
+		{event: "Tornado Warning", want: entity.AlertSeverityExtreme},
+		{event: "Red warning for rain", want: entity.AlertSeverityExtreme},
+		{event: "Winter Storm Warning", want: entity.AlertSeveritySevere},
+		{event: "Orange thunderstorm alert", want: entity.AlertSeveritySevere},
+		{event: "Flood Watch", want: entity.AlertSeverityModerate},
+		{event: "Small Craft Advisory", want: entity.AlertSeverityModerate},
+		{event: "Yellow wind", tags: []string{"Wind"}, want: entity.AlertSeverityModerate},
+		{event: "Special Weather Statement", want: entity.AlertSeverityMinor},
+		{event: "Reduced visibility", want: entity.AlertSeverityUnknown},
+		{event: "Fog", tags: []string{"Extreme low temperature"}, want: entity.AlertSeverityExtreme},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.event, func(t *testing.T) {
+			got := classifyAlertSeverity(entity.WeatherAlert{Event: tt.event, Tags: tt.tags})
+			if got != tt.want {
+				t.Errorf("Expected %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestWeatherService_GetAlerts_FiltersAndOrders(t *testing.T) {
+	// Arrange
+	now := time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)
+	mockRepo := &MockWeatherRepository{
+		oneCall: &entity.OneCall{
+			Alerts: []entity.WeatherAlert{
+				{Event: "Flood Watch", Start: now.Add(-time.Hour), End: now.Add(time.Hour)},
+				{Event: "Special Weather Statement", Start: now.Add(-time.Hour), End: now.Add(time.Hour)},
+				{Event: "Winter Storm Warning", Start: now.Add(-2 * time.Hour), End: now.Add(6 * time.Hour)},
+				{Event: "Tornado Warning", Start: now.Add(2 * time.Hour), End: now.Add(3 * time.Hour)},
+			},
+		},
+	}
+
+	service := NewWeatherService(mockRepo)
+
+	// Act
+	alerts, err := service.GetAlerts(context.Background(), 40.7, -74, entity.AlertSeverityModerate, now)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(mockRepo.lastInclude) != 1 || mockRepo.lastInclude[0] != entity.OneCallAlerts {
+		t.Errorf("Expected only the alerts block to be requested, got %v", mockRepo.lastInclude)
+	}
+	if len(alerts) != 2 {
+		t.Fatalf("Expected 2 alerts, got %d: %+v", len(alerts), alerts)
+	}
+	if alerts[0].Event != "Winter Storm Warning" || alerts[0].Severity != entity.AlertSeveritySevere {
+		t.Errorf("Expected severe storm warning first, got %+v", alerts[0])
+	}
+	if alerts[1].Event != "Flood Watch" {
+		t.Errorf("Expected flood watch second, got %+v", alerts[1])
+	}
+	if mockRepo.oneCall.Alerts[0].Severity != entity.AlertSeverityUnknown {
+		t.Error("Expected repository alerts to be left untouched")
+	}
+}
+
+func TestWeatherService_GetAlerts_NoActiveFilter(t *testing.T) {
+	// Arrange
+	mockRepo := &MockWeatherRepository{
+		oneCall: &entity.OneCall{
+			Alerts: []entity.WeatherAlert{
+				{Event: "Tornado Warning", Start: time.Now().Add(24 * time.Hour)},
+			},
+		},
+	}
+
+	service := NewWeatherService(mockRepo)
+
+	// Act
+	alerts, err := service.GetAlerts(context.Background(), 40.7, -74, entity.AlertSeverityUnknown, time.Time{})
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(alerts) != 1 || alerts[0].Severity != entity.AlertSeverityExtreme {
+		t.Errorf("Expected one extreme alert, got %+v", alerts)
+	}
+}
@@ -0,0 +1,132 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sync"
+	"time"
+
+	"weather-api/internal/core/domain/entity"
+	"weather-api/pkg/language"
+	"weather-api/pkg/units"
+)
+
+const (
+	// MaxHistoryRangeDays is the largest number of days a single range query may span.
+	MaxHistoryRangeDays = 31
+	// historyFanOut bounds the number of concurrent day summary lookups per range query.
+	historyFanOut = 4
+)
+
+// ErrInvalidHistoryRange is returned when a range is reversed or longer than MaxHistoryRangeDays.
+var ErrInvalidHistoryRange = errors.New("invalid history range")
+
+// GetHistoricalWeather retrieves the weather recorded at a past timestamp in the requested unit system and language.
+func (s *WeatherService) GetHistoricalWeather(ctx context.Context, lat float32, lon float32, at time.Time, system units.System, lang language.Code) (*entity.HistoricalWeather, error) {
+	historical, err := s.weatherRepo.GetHistoricalWeather(ctx, lat, lon, at, lang)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertHistoricalWeather(historical, system.OrMetric()), nil
+}
+
+// GetHistoricalRange retrieves one day summary per calendar day between from and to (inclusive)
+// and aggregates them in the requested unit system. Days are fetched concurrently; the first failure
+// cancels the remaining lookups.
+func (s *WeatherService) GetHistoricalRange(ctx context.Context, lat float32, lon float32, from time.Time, to time.Time, system units.System) (*entity.HistoricalRange, error) {
+	days := HistoryRangeDays(from, to)
+	if days < 1 || days > MaxHistoryRangeDays {
+		return nil, ErrInvalidHistoryRange
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	start := truncateToDay(from)
+	summaries := make([]entity.DaySummary, days)
+
+	var (
+		wg       sync.WaitGroup
+		failOnce sync.Once
+		firstErr error
+	)
+	fail := func(err error) {
+		failOnce.Do(func() {
+			firstErr = err
+			cancel()
+		})
+	}
+
+	slots := make(chan struct{}, historyFanOut)
+	for i := 0; i < days; i++ {
+		select {
+		case slots <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+		go func(index int) {
+			defer wg.Done()
+			defer func() { <-slots }()
+
+			summary, err := s.weatherRepo.GetDaySummary(ctx, lat, lon, start.AddDate(0, 0, index))
+			if err != nil {
+				fail(err)
+				return
+			}
+			summaries[index] = convertDaySummary(*summary, system.OrMetric())
+		}(i)
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	return aggregateDaySummaries(lat, lon, start, summaries, system.OrMetric()), nil
+}
+
+// HistoryRangeDays returns the number of calendar days between from and to, inclusive.
+// A reversed range yields zero or a negative number.
+func HistoryRangeDays(from time.Time, to time.Time) int {
+	return int(truncateToDay(to).Sub(truncateToDay(from)).Hours()/24) + 1
+}
+
+func truncateToDay(t time.Time) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, t.Location())
+}
+
+// aggregateDaySummaries computes range-wide extremes, means and totals of days expressed in system.
+func aggregateDaySummaries(lat float32, lon float32, start time.Time, days []entity.DaySummary, system units.System) *entity.HistoricalRange {
+	result := &entity.HistoricalRange{
+		Lat:     lat,
+		Lon:     lon,
+		From:    start,
+		To:      start.AddDate(0, 0, len(days)-1),
+		Days:    days,
+		TempMin: math.Inf(1),
+		TempMax: math.Inf(-1),
+		Units:   system,
+	}
+
+	var midpointSum float64
+	for _, day := range days {
+		result.TempMin = math.Min(result.TempMin, day.TempMin)
+		result.TempMax = math.Max(result.TempMax, day.TempMax)
+		result.MaxWindSpeed = math.Max(result.MaxWindSpeed, day.WindMaxSpeed)
+		result.TotalPrecipitation += day.Precipitation
+		midpointSum += (day.TempMin + day.TempMax) / 2
+	}
+	result.TempMean = midpointSum / float64(len(days))
+
+	return result
+}
@@ -0,0 +1,142 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"weather-api/internal/core/domain/entity"
+	"weather-api/internal/core/domain/repository"
+	"weather-api/pkg/units"
+)
+
+func TestWeatherService_GetHistoricalRange_Aggregates(t *testing.T) {
+	// Arrange
+	from := time.Date(2024, 1, 1, 15, 30, 0, 0, time.UTC)
+	to := time.Date(2024, 1, 3, 8, 0, 0, 0, time.UTC)
+	mockRepo := &MockWeatherRepository{
+		daySummary: func(date time.Time) (*entity.DaySummary, error) {
+			offset := float64(date.Day())
+			return &entity.DaySummary{
+				Date:          date,
+				TempMin:       offset,
+				TempMax:       offset + 10,
+				Precipitation: offset / 2,
+				WindMaxSpeed:  offset * 3,
+			}, nil
+		},
+	}
+
+	service := NewWeatherService(mockRepo)
+
+	// Act
+	historyRange, err := service.GetHistoricalRange(context.Background(), 38.4, 27.1, from, to, units.Metric)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(historyRange.Days) != 3 {
+		t.Fatalf("Expected 3 days, got %d", len(historyRange.Days))
+	}
+	for i, day := range historyRange.Days {
+		if day.Date.Day() != i+1 {
+			t.Errorf("Expected day %d to be January %d, got %s", i, i+1, day.Date)
+		}
+	}
+	if historyRange.TempMin != 1 || historyRange.TempMax != 13 {
+		t.Errorf("Expected min=1 max=13, got min=%f max=%f", historyRange.TempMin, historyRange.TempMax)
+	}
+	if historyRange.TempMean != 7 {
+		t.Errorf("Expected mean=7, got %f", historyRange.TempMean)
+	}
+	if historyRange.TotalPrecipitation != 3 {
+		t.Errorf("Expected total precipitation=3, got %f", historyRange.TotalPrecipitation)
+	}
+	if historyRange.MaxWindSpeed != 9 {
+		t.Errorf("Expected max wind=9, got %f", historyRange.MaxWindSpeed)
+	}
+	if !historyRange.From.Equal(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expected range to start at midnight, got %s", historyRange.From)
+	}
+}
+
+func TestWeatherService_GetHistoricalRange_PropagatesError(t *testing.T) {
+	// Arrange
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	mockRepo := &MockWeatherRepository{
+		daySummary: func(date time.Time) (*entity.DaySummary, error) {
+			if date.Day() == 2 {
+				return nil, repository.ErrAPIError
+			}
+			return &entity.DaySummary{Date: date}, nil
+		},
+	}
+
+	service := NewWeatherService(mockRepo)
+
+	// Act
+	historyRange, err := service.GetHistoricalRange(context.Background(), 38.4, 27.1, from, from.AddDate(0, 0, 4), units.Metric)
+
+	// Assert
+	if !errors.Is(err, repository.ErrAPIError) {
+		t.Errorf("Expected ErrAPIError, got %v", err)
+	}
+	if historyRange != nil {
+		t.Error("Expected nil range on error")
+	}
+}
+
+func TestWeatherService_GetHistoricalRange_CanceledContext(t *testing.T) {
+	// Arrange
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	var calls atomic.Int32
+	mockRepo := &MockWeatherRepository{
+		daySummary: func(date time.Time) (*entity.DaySummary, error) {
+			calls.Add(1)
+			return &entity.DaySummary{Date: date}, nil
+		},
+	}
+
+	service := NewWeatherService(mockRepo)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// Act
+	historyRange, err := service.GetHistoricalRange(ctx, 38.4, 27.1, from, from.AddDate(0, 0, 9), units.Metric)
+
+	// Assert
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+	if historyRange != nil {
+		t.Error("Expected nil range on cancellation")
+	}
+	if calls.Load() != 0 {
+		t.Errorf("Expected no day summary lookups, got %d", calls.Load())
+	}
+}
+
+func TestWeatherService_GetHistoricalRange_InvalidRange(t *testing.T) {
+	from := time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)
+	service := NewWeatherService(&MockWeatherRepository{})
+
+	tests := []struct {
+		name string
+		to   time.Time
+	}{
+		{name: "reversed", to: from.AddDate(0, 0, -1)},
+		{name: "too long", to: from.AddDate(0, 0, MaxHistoryRangeDays)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := service.GetHistoricalRange(context.Background(), 38.4, 27.1, from, tt.to, units.Metric)
+			if !errors.Is(err, ErrInvalidHistoryRange) {
+				t.Errorf("Expected ErrInvalidHistoryRange, got %v", err)
+			}
+		})
+	}
+}
@@ -1,15 +1,30 @@
 package service
 
 import (
+	"context"
+	"sort"
+	"time"
+
 	"weather-api/internal/core/domain/entity"
 	"weather-api/internal/core/domain/repository"
+	"weather-api/pkg/language"
+	"weather-api/pkg/units"
 )
 
 // WeatherServiceInterface defines the interface for the core weather business logic.
 // It returns a pure domain entity or an error.
 type WeatherServiceInterface interface {
-	GetWeatherByCity(city string) (*entity.Weather, error)
-	GetWeatherOverviewByLatLong(lon float32, lat float32) (*entity.WeatherOverview, error)
+	GetWeatherByCity(ctx context.Context, city string, system units.System, lang language.Code) (*entity.Weather, error)
+	GetWeatherByCoordinates(ctx context.Context, lat float32, lon float32, system units.System, lang language.Code) (*entity.Weather, error)
+	GetWeatherOverviewByLatLong(ctx context.Context, lon float32, lat float32, system units.System) (*entity.WeatherOverview, error)
+	GetForecastByCity(ctx context.Context, city string, system units.System, lang language.Code) (*entity.Forecast, error)
+	GetOneCall(ctx context.Context, lat float32, lon float32, include []entity.OneCallBlock, system units.System, lang language.Code) (*entity.OneCall, error)
+	GetAirQuality(ctx context.Context, lat float32, lon float32) (*entity.AirQuality, error)
+	GetAirQualityForecast(ctx context.Context, lat float32, lon float32) (*entity.AirQuality, error)
+	GetAirQualityHistory(ctx context.Context, lat float32, lon float32, start time.Time, end time.Time) (*entity.AirQuality, error)
+	GetHistoricalWeather(ctx context.Context, lat float32, lon float32, at time.Time, system units.System, lang language.Code) (*entity.HistoricalWeather, error)
+	GetHistoricalRange(ctx context.Context, lat float32, lon float32, from time.Time, to time.Time, system units.System) (*entity.HistoricalRange, error)
+	GetAlerts(ctx context.Context, lat float32, lon float32, minSeverity entity.AlertSeverity, activeAt time.Time) ([]entity.WeatherAlert, error)
 }
 
 // WeatherService handles weather business logic.
@@ -25,20 +40,30 @@ func NewWeatherService(weatherRepo repository.WeatherRepository) *WeatherService
 	}
 }
 
-// GetWeatherByCity retrieves weather information for a given city.
+// GetWeatherByCity retrieves weather information for a given city in the requested unit system and language.
 // It returns the core domain model or an error if the data cannot be fetched.
-func (s *WeatherService) GetWeatherByCity(city string) (*entity.Weather, error) {
-	weather, err := s.weatherRepo.GetWeatherByCity(city)
+func (s *WeatherService) GetWeatherByCity(ctx context.Context, city string, system units.System, lang language.Code) (*entity.Weather, error) {
+	weather, err := s.weatherRepo.GetWeatherByCity(ctx, city, lang)
 	if err != nil {
 		return nil, err
 	}
 
-	return weather, nil
+	return convertWeather(weather, system.OrMetric()), nil
 }
 
-func (s *WeatherService) GetWeatherOverviewByLatLong(lon float32, lat float32) (*entity.WeatherOverview, error) {
+// GetWeatherByCoordinates retrieves the current weather at a coordinate in the requested unit system and language.
+func (s *WeatherService) GetWeatherByCoordinates(ctx context.Context, lat float32, lon float32, system units.System, lang language.Code) (*entity.Weather, error) {
+	weather, err := s.weatherRepo.GetWeatherByCoordinates(ctx, lat, lon, lang)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertWeather(weather, system.OrMetric()), nil
+}
 
-	weatherOverview, err := s.weatherRepo.GetWeatherOverviewByLatLong(lon, lat)
+func (s *WeatherService) GetWeatherOverviewByLatLong(ctx context.Context, lon float32, lat float32, system units.System) (*entity.WeatherOverview, error) {
+
+	weatherOverview, err := s.weatherRepo.GetWeatherOverviewByLatLong(ctx, lon, lat, system.OrMetric())
 
 	if err != nil {
 		return nil, err
@@ -47,3 +72,78 @@ func (s *WeatherService) GetWeatherOverviewByLatLong(lon float32, lat float32) (
 	return weatherOverview, nil
 
 }
+
+// GetForecastByCity retrieves the upcoming forecast for a city in the requested unit system and language.
+// Slots are returned in ascending time order regardless of the order the provider used.
+func (s *WeatherService) GetForecastByCity(ctx context.Context, city string, system units.System, lang language.Code) (*entity.Forecast, error) {
+	forecast, err := s.weatherRepo.GetForecastByCity(ctx, city, lang)
+	if err != nil {
+		return nil, err
+	}
+
+	// Work on a copy so the repository's value is never mutated.
+	ordered := *forecast
+	ordered.Slots = append([]entity.ForecastSlot(nil), forecast.Slots...)
+	sort.SliceStable(ordered.Slots, func(i, j int) bool {
+		return ordered.Slots[i].Time.Before(ordered.Slots[j].Time)
+	})
+	convertForecastSlots(ordered.Slots, forecast.Units.OrMetric(), system.OrMetric())
+	ordered.Units = system.OrMetric()
+
+	return &ordered, nil
+}
+
+// GetOneCall retrieves the One Call blocks listed in include for a coordinate.
+// Duplicate blocks are ignored and an empty include requests every block.
+// Alerts carry a normalized severity; measurements and descriptions follow the requested unit system and language.
+func (s *WeatherService) GetOneCall(ctx context.Context, lat float32, lon float32, include []entity.OneCallBlock, system units.System, lang language.Code) (*entity.OneCall, error) {
+	seen := make(map[entity.OneCallBlock]bool, len(include))
+	blocks := make([]entity.OneCallBlock, 0, len(include))
+	for _, block := range include {
+		if seen[block] {
+			continue
+		}
+		seen[block] = true
+		blocks = append(blocks, block)
+	}
+
+	oneCall, err := s.weatherRepo.GetOneCall(ctx, lat, lon, blocks, lang)
+	if err != nil {
+		return nil, err
+	}
+
+	result := convertOneCall(oneCall, system.OrMetric())
+	result.Alerts = withSeverity(oneCall.Alerts)
+
+	return result, nil
+}
+
+// GetAirQuality retrieves the current air quality index and pollutant concentrations for a coordinate.
+func (s *WeatherService) GetAirQuality(ctx context.Context, lat float32, lon float32) (*entity.AirQuality, error) {
+	airQuality, err := s.weatherRepo.GetAirQuality(ctx, lat, lon)
+	if err != nil {
+		return nil, err
+	}
+
+	return airQuality, nil
+}
+
+// GetAirQualityForecast retrieves the hourly air quality forecast for a coordinate.
+func (s *WeatherService) GetAirQualityForecast(ctx context.Context, lat float32, lon float32) (*entity.AirQuality, error) {
+	airQuality, err := s.weatherRepo.GetAirQualityForecast(ctx, lat, lon)
+	if err != nil {
+		return nil, err
+	}
+
+	return airQuality, nil
+}
+
+// GetAirQualityHistory retrieves hourly air quality readings between start and end.
+func (s *WeatherService) GetAirQualityHistory(ctx context.Context, lat float32, lon float32, start time.Time, end time.Time) (*entity.AirQuality, error) {
+	airQuality, err := s.weatherRepo.GetAirQualityHistory(ctx, lat, lon, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	return airQuality, nil
+}
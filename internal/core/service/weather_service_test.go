@@ -1,28 +1,80 @@
 package service
 
 import (
+	"context"
 	"errors"
+	"math"
 	"testing"
+	"time"
 
 	"weather-api/internal/core/domain/entity"
 	"weather-api/internal/core/domain/repository"
+	"weather-api/pkg/language"
+	"weather-api/pkg/units"
 )
 
 // MockWeatherRepository is a mock implementation for testing
 type MockWeatherRepository struct {
 	weather  *entity.Weather
 	overview *entity.WeatherOverview
+	forecast *entity.Forecast
+	oneCall  *entity.OneCall
+	air      *entity.AirQuality
+	history  *entity.HistoricalWeather
 	err      error
+
+	daySummary func(date time.Time) (*entity.DaySummary, error)
+
+	lastInclude []entity.OneCallBlock
+	lastLang    language.Code
+}
+
+func (m *MockWeatherRepository) GetWeatherByCity(ctx context.Context, city string, lang language.Code) (*entity.Weather, error) {
+	m.lastLang = lang
+	return m.weather, m.err
 }
 
-func (m *MockWeatherRepository) GetWeatherByCity(city string) (*entity.Weather, error) {
+func (m *MockWeatherRepository) GetWeatherByCoordinates(ctx context.Context, lat float32, lon float32, lang language.Code) (*entity.Weather, error) {
+	m.lastLang = lang
 	return m.weather, m.err
 }
 
-func (m *MockWeatherRepository) GetWeatherOverviewByLatLong(lon float32, lat float32) (*entity.WeatherOverview, error) {
+func (m *MockWeatherRepository) GetWeatherOverviewByLatLong(ctx context.Context, lon float32, lat float32, system units.System) (*entity.WeatherOverview, error) {
 	return m.overview, m.err
 }
 
+func (m *MockWeatherRepository) GetForecastByCity(ctx context.Context, city string, lang language.Code) (*entity.Forecast, error) {
+	return m.forecast, m.err
+}
+
+func (m *MockWeatherRepository) GetOneCall(ctx context.Context, lat float32, lon float32, include []entity.OneCallBlock, lang language.Code) (*entity.OneCall, error) {
+	m.lastInclude = include
+	return m.oneCall, m.err
+}
+
+func (m *MockWeatherRepository) GetAirQuality(ctx context.Context, lat float32, lon float32) (*entity.AirQuality, error) {
+	return m.air, m.err
+}
+
+func (m *MockWeatherRepository) GetAirQualityForecast(ctx context.Context, lat float32, lon float32) (*entity.AirQuality, error) {
+	return m.air, m.err
+}
+
+func (m *MockWeatherRepository) GetAirQualityHistory(ctx context.Context, lat float32, lon float32, start time.Time, end time.Time) (*entity.AirQuality, error) {
+	return m.air, m.err
+}
+
+func (m *MockWeatherRepository) GetHistoricalWeather(ctx context.Context, lat float32, lon float32, at time.Time, lang language.Code) (*entity.HistoricalWeather, error) {
+	return m.history, m.err
+}
+
+func (m *MockWeatherRepository) GetDaySummary(ctx context.Context, lat float32, lon float32, date time.Time) (*entity.DaySummary, error) {
+	if m.daySummary != nil {
+		return m.daySummary(date)
+	}
+	return nil, m.err
+}
+
 func TestWeatherService_GetWeatherByCity_Success(t *testing.T) {
 	// Arrange
 	mockRepo := &MockWeatherRepository{
@@ -39,7 +91,7 @@ func TestWeatherService_GetWeatherByCity_Success(t *testing.T) {
 	service := NewWeatherService(mockRepo)
 
 	// Act
-	weather, err := service.GetWeatherByCity("Istanbul")
+	weather, err := service.GetWeatherByCity(context.Background(), "Istanbul", units.Metric, language.English)
 
 	// Assert
 	if err != nil {
@@ -66,7 +118,7 @@ func TestWeatherService_GetWeatherByCity_Error(t *testing.T) {
 	service := NewWeatherService(mockRepo)
 
 	// Act
-	weather, err := service.GetWeatherByCity("InvalidCity")
+	weather, err := service.GetWeatherByCity(context.Background(), "InvalidCity", units.Metric, language.English)
 
 	// Assert
 	if err == nil {
@@ -90,7 +142,7 @@ func TestWeatherService_GetWeatherByCity_EmptyCity(t *testing.T) {
 	service := NewWeatherService(mockRepo)
 
 	// Act
-	weather, err := service.GetWeatherByCity("")
+	weather, err := service.GetWeatherByCity(context.Background(), "", units.Metric, language.English)
 
 	// Assert
 	if err == nil {
@@ -103,3 +155,241 @@ func TestWeatherService_GetWeatherByCity_EmptyCity(t *testing.T) {
 		t.Error("Expected nil weather on error")
 	}
 }
+
+func TestWeatherService_GetWeatherByCity_Imperial(t *testing.T) {
+	// Arrange
+	repoWeather := &entity.Weather{
+		City:        "New York",
+		Temperature: 20,
+		WindSpeed:   4.4704,
+		Units:       units.Metric,
+	}
+	mockRepo := &MockWeatherRepository{weather: repoWeather}
+
+	service := NewWeatherService(mockRepo)
+
+	// Act
+	weather, err := service.GetWeatherByCity(context.Background(), "New York", units.Imperial, language.English)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if weather.Units != units.Imperial {
+		t.Errorf("Expected units=imperial, got %s", weather.Units)
+	}
+	if math.Abs(weather.Temperature-68) > 1e-9 {
+		t.Errorf("Expected temperature=68, got %f", weather.Temperature)
+	}
+	if math.Abs(weather.WindSpeed-10) > 1e-9 {
+		t.Errorf("Expected wind speed=10, got %f", weather.WindSpeed)
+	}
+	if repoWeather.Temperature != 20 || repoWeather.Units != units.Metric {
+		t.Error("Expected repository weather to be left untouched")
+	}
+}
+
+func TestWeatherService_GetWeatherByCity_PassesLanguage(t *testing.T) {
+	// Arrange
+	mockRepo := &MockWeatherRepository{
+		weather: &entity.Weather{City: "Berlin", Description: "leichter Regen", Language: "de"},
+	}
+
+	service := NewWeatherService(mockRepo)
+
+	// Act
+	weather, err := service.GetWeatherByCity(context.Background(), "Berlin", units.Metric, "de")
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if mockRepo.lastLang != "de" {
+		t.Errorf("Expected lang=de passed to repository, got %q", mockRepo.lastLang)
+	}
+	if weather.Language != "de" || weather.Description != "leichter Regen" {
+		t.Errorf("Expected German description, got %q (%s)", weather.Description, weather.Language)
+	}
+}
+
+func TestWeatherService_GetWeatherByCoordinates(t *testing.T) {
+	// Arrange
+	observed := time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)
+	mockRepo := &MockWeatherRepository{weather: &entity.Weather{
+		City:        "London",
+		Temperature: 10,
+		Humidity:    70,
+		WindSpeed:   3,
+		Description: "overcast clouds",
+		Timestamp:   observed,
+		Units:       units.Metric,
+		Language:    language.English,
+		Provider:    "openweather",
+	}}
+
+	service := NewWeatherService(mockRepo)
+
+	// Act
+	weather, err := service.GetWeatherByCoordinates(context.Background(), 51.5, -0.12, units.Imperial, "de")
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if mockRepo.lastLang != "de" {
+		t.Errorf("Expected the language to be passed on, got %q", mockRepo.lastLang)
+	}
+	if weather.Temperature != 50 || weather.Units != units.Imperial {
+		t.Errorf("Expected 50 imperial, got %f %s", weather.Temperature, weather.Units)
+	}
+	if weather.City != "London" || weather.Description != "overcast clouds" || !weather.Timestamp.Equal(observed) || weather.Provider != "openweather" {
+		t.Errorf("Unexpected weather %+v", weather)
+	}
+}
+
+func TestWeatherService_GetForecastByCity_Standard(t *testing.T) {
+	// Arrange
+	repoForecast := &entity.Forecast{
+		City:  "London",
+		Slots: []entity.ForecastSlot{{Temperature: 0, FeelsLike: -1, TempMin: -2, TempMax: 1}},
+		Units: units.Metric,
+	}
+	mockRepo := &MockWeatherRepository{forecast: repoForecast}
+
+	service := NewWeatherService(mockRepo)
+
+	// Act
+	forecast, err := service.GetForecastByCity(context.Background(), "London", units.Standard, language.English)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if forecast.Units != units.Standard {
+		t.Errorf("Expected units=standard, got %s", forecast.Units)
+	}
+	if slot := forecast.Slots[0]; math.Abs(slot.Temperature-273.15) > 1e-9 || math.Abs(slot.TempMin-271.15) > 1e-9 {
+		t.Errorf("Expected Kelvin temperatures, got %+v", slot)
+	}
+	if repoForecast.Slots[0].Temperature != 0 {
+		t.Error("Expected repository forecast to be left untouched")
+	}
+}
+
+func TestWeatherService_GetForecastByCity_OrdersSlots(t *testing.T) {
+	// Arrange
+	base := time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)
+	repoForecast := &entity.Forecast{
+		City: "London",
+		Slots: []entity.ForecastSlot{
+			{Time: base.Add(6 * time.Hour), Temperature: 3},
+			{Time: base, Temperature: 1},
+			{Time: base.Add(3 * time.Hour), Temperature: 2},
+		},
+	}
+	mockRepo := &MockWeatherRepository{forecast: repoForecast}
+
+	service := NewWeatherService(mockRepo)
+
+	// Act
+	forecast, err := service.GetForecastByCity(context.Background(), "London", units.Metric, language.English)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(forecast.Slots) != 3 {
+		t.Fatalf("Expected 3 slots, got %d", len(forecast.Slots))
+	}
+	for i, want := range []float64{1, 2, 3} {
+		if forecast.Slots[i].Temperature != want {
+			t.Errorf("Expected slot %d temperature=%f, got %f", i, want, forecast.Slots[i].Temperature)
+		}
+	}
+	if repoForecast.Slots[0].Temperature != 3 {
+		t.Error("Expected repository forecast to be left untouched")
+	}
+}
+
+func TestWeatherService_GetForecastByCity_Error(t *testing.T) {
+	// Arrange
+	mockRepo := &MockWeatherRepository{err: repository.ErrCityNotFound}
+
+	service := NewWeatherService(mockRepo)
+
+	// Act
+	forecast, err := service.GetForecastByCity(context.Background(), "InvalidCity", units.Metric, language.English)
+
+	// Assert
+	if !errors.Is(err, repository.ErrCityNotFound) {
+		t.Errorf("Expected ErrCityNotFound, got %v", err)
+	}
+	if forecast != nil {
+		t.Error("Expected nil forecast on error")
+	}
+}
+
+func TestWeatherService_GetOneCall_DeduplicatesInclude(t *testing.T) {
+	// Arrange
+	mockRepo := &MockWeatherRepository{oneCall: &entity.OneCall{Timezone: "Europe/London"}}
+
+	service := NewWeatherService(mockRepo)
+
+	// Act
+	oneCall, err := service.GetOneCall(context.Background(), 51.5, -0.12, []entity.OneCallBlock{
+		entity.OneCallHourly, entity.OneCallDaily, entity.OneCallHourly,
+	}, units.Metric, language.English)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if oneCall.Timezone != "Europe/London" {
+		t.Errorf("Expected timezone=Europe/London, got %s", oneCall.Timezone)
+	}
+	if len(mockRepo.lastInclude) != 2 {
+		t.Fatalf("Expected 2 blocks passed to repository, got %v", mockRepo.lastInclude)
+	}
+	if mockRepo.lastInclude[0] != entity.OneCallHourly || mockRepo.lastInclude[1] != entity.OneCallDaily {
+		t.Errorf("Expected [hourly daily], got %v", mockRepo.lastInclude)
+	}
+}
+
+func TestWeatherService_GetAirQuality_Success(t *testing.T) {
+	// Arrange
+	mockRepo := &MockWeatherRepository{air: &entity.AirQuality{
+		Readings: []entity.AirQualityReading{{AQI: 2, Components: entity.AirComponents{PM2_5: 8.1}}},
+	}}
+
+	service := NewWeatherService(mockRepo)
+
+	// Act
+	airQuality, err := service.GetAirQuality(context.Background(), 51.5, -0.12)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(airQuality.Readings) != 1 || airQuality.Readings[0].AQI != 2 {
+		t.Errorf("Expected a single reading with AQI=2, got %+v", airQuality.Readings)
+	}
+}
+
+func TestWeatherService_GetAirQualityHistory_Error(t *testing.T) {
+	// Arrange
+	mockRepo := &MockWeatherRepository{err: repository.ErrAPIError}
+
+	service := NewWeatherService(mockRepo)
+
+	// Act
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	airQuality, err := service.GetAirQualityHistory(context.Background(), 51.5, -0.12, start, start.Add(24*time.Hour))
+
+	// Assert
+	if !errors.Is(err, repository.ErrAPIError) {
+		t.Errorf("Expected ErrAPIError, got %v", err)
+	}
+	if airQuality != nil {
+		t.Error("Expected nil air quality on error")
+	}
+}
@@ -0,0 +1,109 @@
+package service
+
+import (
+	"weather-api/internal/core/domain/entity"
+	"weather-api/pkg/units"
+)
+
+// The converters below return copies expressed in the target unit system and never modify their
+// input, which may be shared with the repository's cache. Entities without a unit system are metric.
+
+func convertWeather(weather *entity.Weather, to units.System) *entity.Weather {
+	from := weather.Units.OrMetric()
+	converted := *weather
+	converted.Temperature = units.Temperature(weather.Temperature, from, to)
+	converted.WindSpeed = units.Speed(weather.WindSpeed, from, to)
+	converted.Units = to
+
+	if c := weather.Consensus; c != nil {
+		consensus := *c
+		consensus.TemperatureSpread = units.TemperatureDelta(c.TemperatureSpread, from, to)
+		consensus.WindSpeedSpread = units.Speed(c.WindSpeedSpread, from, to)
+		converted.Consensus = &consensus
+	}
+
+	return &converted
+}
+
+// convertForecastSlots converts slots in place; callers pass a slice they own.
+func convertForecastSlots(slots []entity.ForecastSlot, from units.System, to units.System) {
+	for i := range slots {
+		slot := &slots[i]
+		slot.Temperature = units.Temperature(slot.Temperature, from, to)
+		slot.FeelsLike = units.Temperature(slot.FeelsLike, from, to)
+		slot.TempMin = units.Temperature(slot.TempMin, from, to)
+		slot.TempMax = units.Temperature(slot.TempMax, from, to)
+		slot.WindSpeed = units.Speed(slot.WindSpeed, from, to)
+	}
+}
+
+func convertOneCall(oneCall *entity.OneCall, to units.System) *entity.OneCall {
+	from := oneCall.Units.OrMetric()
+	converted := *oneCall
+	converted.Units = to
+
+	if cur := oneCall.Current; cur != nil {
+		current := *cur
+		current.Temperature = units.Temperature(cur.Temperature, from, to)
+		current.FeelsLike = units.Temperature(cur.FeelsLike, from, to)
+		current.DewPoint = units.Temperature(cur.DewPoint, from, to)
+		current.WindSpeed = units.Speed(cur.WindSpeed, from, to)
+		converted.Current = &current
+	}
+
+	if oneCall.Hourly != nil {
+		converted.Hourly = make([]entity.HourlyConditions, len(oneCall.Hourly))
+		for i, hour := range oneCall.Hourly {
+			hour.Temperature = units.Temperature(hour.Temperature, from, to)
+			hour.FeelsLike = units.Temperature(hour.FeelsLike, from, to)
+			hour.WindSpeed = units.Speed(hour.WindSpeed, from, to)
+			converted.Hourly[i] = hour
+		}
+	}
+
+	if oneCall.Daily != nil {
+		converted.Daily = make([]entity.DailyConditions, len(oneCall.Daily))
+		for i, day := range oneCall.Daily {
+			day.TempMin = units.Temperature(day.TempMin, from, to)
+			day.TempMax = units.Temperature(day.TempMax, from, to)
+			day.TempDay = units.Temperature(day.TempDay, from, to)
+			day.TempNight = units.Temperature(day.TempNight, from, to)
+			day.WindSpeed = units.Speed(day.WindSpeed, from, to)
+			converted.Daily[i] = day
+		}
+	}
+
+	return &converted
+}
+
+func convertHistoricalWeather(historical *entity.HistoricalWeather, to units.System) *entity.HistoricalWeather {
+	from := historical.Units.OrMetric()
+	converted := *historical
+	converted.Units = to
+
+	if historical.Observations != nil {
+		converted.Observations = make([]entity.HistoricalObservation, len(historical.Observations))
+		for i, observation := range historical.Observations {
+			observation.Temperature = units.Temperature(observation.Temperature, from, to)
+			observation.FeelsLike = units.Temperature(observation.FeelsLike, from, to)
+			observation.DewPoint = units.Temperature(observation.DewPoint, from, to)
+			observation.WindSpeed = units.Speed(observation.WindSpeed, from, to)
+			converted.Observations[i] = observation
+		}
+	}
+
+	return &converted
+}
+
+func convertDaySummary(day entity.DaySummary, to units.System) entity.DaySummary {
+	from := day.Units.OrMetric()
+	day.TempMin = units.Temperature(day.TempMin, from, to)
+	day.TempMax = units.Temperature(day.TempMax, from, to)
+	day.TempMorning = units.Temperature(day.TempMorning, from, to)
+	day.TempAfternoon = units.Temperature(day.TempAfternoon, from, to)
+	day.TempEvening = units.Temperature(day.TempEvening, from, to)
+	day.TempNight = units.Temperature(day.TempNight, from, to)
+	day.WindMaxSpeed = units.Speed(day.WindMaxSpeed, from, to)
+	day.Units = to
+	return day
+}
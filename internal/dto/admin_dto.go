@@ -0,0 +1,83 @@
+package dto
+
+import "time"
+
+// ShadowFieldStatsData holds the absolute differences observed for one compared field.
+type ShadowFieldStatsData struct {
+	Compared    uint64  `json:"compared" example:"120"`
+	Diverged    uint64  `json:"diverged" example:"7"`
+	MeanAbsDiff float64 `json:"mean_abs_diff" example:"0.6"`
+	MaxAbsDiff  float64 `json:"max_abs_diff" example:"3.1"`
+}
+
+// ShadowOperationStatsData counts the shadow calls made for one operation.
+type ShadowOperationStatsData struct {
+	Sampled         uint64                          `json:"sampled" example:"130"`
+	Dropped         uint64                          `json:"dropped" example:"2"`
+	CandidateErrors uint64                          `json:"candidate_errors" example:"8"`
+	Compared        uint64                          `json:"compared" example:"120"`
+	Divergent       uint64                          `json:"divergent" example:"9"`
+	Fields          map[string]ShadowFieldStatsData `json:"fields"`
+}
+
+// ShadowStatsData reports how the shadow candidate's answers compare to the primary provider.
+type ShadowStatsData struct {
+	Enabled       bool                                `json:"enabled" example:"true"`
+	Candidate     string                              `json:"candidate,omitempty" example:"openmeteo"`
+	SamplePercent float64                             `json:"sample_percent,omitempty" example:"10"`
+	Operations    map[string]ShadowOperationStatsData `json:"operations,omitempty"`
+}
+
+// ShadowStatsResponse is the response wrapper for the shadow statistics endpoint.
+type ShadowStatsResponse struct {
+	Success bool             `json:"success" example:"true"`
+	Data    *ShadowStatsData `json:"data,omitempty"`
+	Error   string           `json:"error,omitempty"`
+}
+
+// QuotaWindowData holds the calls used in one quota window; limit and remaining are omitted when unlimited.
+type QuotaWindowData struct {
+	Used      int       `json:"used" example:"412"`
+	Limit     int       `json:"limit,omitempty" example:"1000"`
+	Remaining *int      `json:"remaining,omitempty" example:"588"`
+	ResetAt   time.Time `json:"reset_at" example:"2024-01-02T00:00:00Z"`
+}
+
+// QuotaEndpointUsageData reports the usage of one budgeted upstream endpoint.
+type QuotaEndpointUsageData struct {
+	Endpoint string          `json:"endpoint" example:"3.0"`
+	Minute   QuotaWindowData `json:"minute"`
+	Day      QuotaWindowData `json:"day"`
+	Rejected uint64          `json:"rejected" example:"3"`
+}
+
+// QuotaUsageData reports the upstream call quota usage per endpoint.
+type QuotaUsageData struct {
+	Enabled   bool                     `json:"enabled" example:"true"`
+	Endpoints []QuotaEndpointUsageData `json:"endpoints,omitempty"`
+}
+
+// QuotaUsageResponse is the response wrapper for the quota usage endpoint.
+type QuotaUsageResponse struct {
+	Success bool            `json:"success" example:"true"`
+	Data    *QuotaUsageData `json:"data,omitempty"`
+	Error   string          `json:"error,omitempty"`
+}
+
+// CircuitBreakerData reports the state and counts of one upstream circuit breaker.
+type CircuitBreakerData struct {
+	Name                string `json:"name" example:"openweather-onecall"`
+	State               string `json:"state" example:"closed"`
+	Requests            uint32 `json:"requests" example:"12"`
+	Successes           uint32 `json:"successes" example:"11"`
+	Failures            uint32 `json:"failures" example:"1"`
+	ConsecutiveFailures uint32 `json:"consecutive_failures" example:"0"`
+	Rejected            uint64 `json:"rejected" example:"0"`
+}
+
+// CircuitBreakersResponse is the response wrapper for the circuit breakers endpoint.
+type CircuitBreakersResponse struct {
+	Success bool                 `json:"success" example:"true"`
+	Data    []CircuitBreakerData `json:"data,omitempty"`
+	Error   string               `json:"error,omitempty"`
+}
@@ -0,0 +1,49 @@
+package dto
+
+import "time"
+
+// CreateAPIKeyRequest is the body of the create API key endpoint.
+type CreateAPIKeyRequest struct {
+	Name      string     `json:"name" binding:"required,max=100" example:"nightly-store-sync"`
+	Scopes    []string   `json:"scopes" binding:"required,min=1" example:"weather:read"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty" example:"2025-01-01T00:00:00Z"`
+}
+
+// APIKeyData describes an API key. The secret is never part of it.
+type APIKeyData struct {
+	ID        string     `json:"id" example:"3f9c1a7be2d04c15"`
+	Name      string     `json:"name" example:"nightly-store-sync"`
+	Prefix    string     `json:"prefix" example:"wapi_Xk3q9Z"`
+	Scopes    []string   `json:"scopes" example:"weather:read"`
+	CreatedAt time.Time  `json:"created_at" example:"2024-01-09T14:00:00Z"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty" example:"2025-01-01T00:00:00Z"`
+	RevokedAt *time.Time `json:"revoked_at,omitempty" example:"2024-06-01T08:30:00Z"`
+	Status    string     `json:"status" example:"active" enums:"active,expired,revoked"`
+}
+
+// CreatedAPIKeyData is a newly created key together with its secret, which is shown only this once.
+type CreatedAPIKeyData struct {
+	APIKeyData
+	Key string `json:"key" example:"wapi_Xk3q9Z..."`
+}
+
+// CreatedAPIKeyResponse is the response wrapper for the create API key endpoint.
+type CreatedAPIKeyResponse struct {
+	Success bool               `json:"success" example:"true"`
+	Data    *CreatedAPIKeyData `json:"data,omitempty"`
+	Error   string             `json:"error,omitempty" example:"invalid api key scope: weather:write"`
+}
+
+// APIKeyResponse is the response wrapper for a single API key.
+type APIKeyResponse struct {
+	Success bool        `json:"success" example:"true"`
+	Data    *APIKeyData `json:"data,omitempty"`
+	Error   string      `json:"error,omitempty" example:"api key not found"`
+}
+
+// APIKeyListResponse is the response wrapper for the list API keys endpoint.
+type APIKeyListResponse struct {
+	Success bool         `json:"success" example:"true"`
+	Data    []APIKeyData `json:"data,omitempty"`
+	Error   string       `json:"error,omitempty"`
+}
@@ -0,0 +1,38 @@
+package dto
+
+// BatchWeatherItemRequest names one location of a batch, either by city or by lat and lon.
+type BatchWeatherItemRequest struct {
+	City string   `json:"city,omitempty" binding:"omitempty,alphaunicode,min=2" example:"London"`
+	Lat  *float32 `json:"lat,omitempty" binding:"omitempty,gte=-90,lte=90" example:"51.5"`
+	Lon  *float32 `json:"lon,omitempty" binding:"omitempty,gte=-180,lte=180" example:"-0.12"`
+}
+
+// BatchWeatherRequest is the body of the batch weather endpoint.
+type BatchWeatherRequest struct {
+	Items []BatchWeatherItemRequest `json:"items"`
+}
+
+// BatchWeatherItemData is the outcome of one batch item. Status is the HTTP status the same lookup
+// would have returned on its own.
+type BatchWeatherItemData struct {
+	Index   int                     `json:"index" example:"0"`
+	Query   BatchWeatherItemRequest `json:"query"`
+	Success bool                    `json:"success" example:"true"`
+	Status  int                     `json:"status" example:"200"`
+	Data    *WeatherData            `json:"data,omitempty"`
+	Error   string                  `json:"error,omitempty" example:"city 'Atlantis' not found"`
+}
+
+// BatchWeatherData holds the per-item results in request order.
+type BatchWeatherData struct {
+	Items     []BatchWeatherItemData `json:"items"`
+	Succeeded int                    `json:"succeeded" example:"39"`
+	Failed    int                    `json:"failed" example:"1"`
+}
+
+// BatchWeatherResponse is the response wrapper for the batch weather endpoint.
+type BatchWeatherResponse struct {
+	Success bool              `json:"success" example:"true"`
+	Data    *BatchWeatherData `json:"data,omitempty"`
+	Error   string            `json:"error,omitempty" example:"batch must contain between 1 and 50 items"`
+}
@@ -0,0 +1,18 @@
+package dto
+
+// LocationData describes a place returned by the geocoding endpoints.
+type LocationData struct {
+	Name       string            `json:"name" example:"Paris"`
+	LocalNames map[string]string `json:"local_names,omitempty"`
+	Country    string            `json:"country" example:"FR"`
+	State      string            `json:"state,omitempty" example:"Ile-de-France"`
+	Lat        float32           `json:"lat" example:"48.8589"`
+	Lon        float32           `json:"lon" example:"2.32"`
+}
+
+// LocationsResponse is the response wrapper for the geocoding endpoints.
+type LocationsResponse struct {
+	Success bool           `json:"success" example:"true"`
+	Data    []LocationData `json:"data,omitempty"`
+	Error   string         `json:"error,omitempty" example:"q is required"`
+}
@@ -0,0 +1,40 @@
+package dto
+
+import "time"
+
+// LivenessData reports that the process is up and serving requests.
+type LivenessData struct {
+	Status string `json:"status" example:"alive"`
+}
+
+// LivenessResponse is the response wrapper for the liveness endpoint.
+type LivenessResponse struct {
+	Success bool          `json:"success" example:"true"`
+	Data    *LivenessData `json:"data,omitempty"`
+	Error   string        `json:"error,omitempty"`
+}
+
+// HealthCheckData reports the outcome of one readiness check. Cached is true when the result of the
+// run at checked_at was reused instead of checking again.
+type HealthCheckData struct {
+	Name      string    `json:"name" example:"upstream:openweather"`
+	Status    string    `json:"status" example:"up" enums:"up,degraded,down"`
+	Error     string    `json:"error,omitempty" example:"circuit breaker openweather-onecall is open"`
+	LatencyMs float64   `json:"latency_ms" example:"84.2"`
+	CheckedAt time.Time `json:"checked_at" example:"2024-01-01T12:00:00Z"`
+	Cached    bool      `json:"cached" example:"true"`
+}
+
+// ReadinessData reports whether the service can serve traffic and the checks that decided it. A
+// degraded service is ready, but some optional check failed.
+type ReadinessData struct {
+	Status string            `json:"status" example:"ready" enums:"ready,degraded,not_ready"`
+	Checks []HealthCheckData `json:"checks"`
+}
+
+// ReadinessResponse is the response wrapper for the readiness endpoint.
+type ReadinessResponse struct {
+	Success bool           `json:"success" example:"true"`
+	Data    *ReadinessData `json:"data,omitempty"`
+	Error   string         `json:"error,omitempty"`
+}
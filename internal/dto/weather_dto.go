@@ -4,12 +4,26 @@ import "time"
 
 // WeatherData defines the structure of the weather data returned to the client.
 type WeatherData struct {
-	City        string    `json:"city" example:"London"`
-	Temperature float64   `json:"temperature" example:"15.5"`
-	Description string    `json:"description" example:"scattered clouds"`
-	Humidity    int       `json:"humidity" example:"80"`
-	WindSpeed   float64   `json:"wind_speed" example:"4.5"`
-	Timestamp   time.Time `json:"timestamp"`
+	City        string                `json:"city" example:"London"`
+	Temperature float64               `json:"temperature" example:"15.5"`
+	Description string                `json:"description" example:"scattered clouds"`
+	Humidity    int                   `json:"humidity" example:"80"`
+	WindSpeed   float64               `json:"wind_speed" example:"4.5"`
+	Timestamp   time.Time             `json:"timestamp"`
+	Units       string                `json:"units" example:"metric"`
+	Lang        string                `json:"lang" example:"en"`
+	Provider    string                `json:"provider,omitempty" example:"openweather"`
+	Consensus   *WeatherConsensusData `json:"consensus,omitempty"`
+}
+
+// WeatherConsensusData describes how a blended reading was produced; spreads are max minus min.
+type WeatherConsensusData struct {
+	Strategy          string   `json:"strategy" example:"median"`
+	Providers         []string `json:"providers" example:"openweather,openmeteo"`
+	FailedProviders   []string `json:"failed_providers,omitempty"`
+	TemperatureSpread float64  `json:"temperature_spread" example:"0.8"`
+	HumiditySpread    float64  `json:"humidity_spread" example:"4"`
+	WindSpeedSpread   float64  `json:"wind_speed_spread" example:"1.2"`
 }
 
 type WeatherOverviewData struct {
@@ -19,6 +33,196 @@ type WeatherOverviewData struct {
 	Date            string  `json:"date" example:"2023-04-27"`
 	Units           string  `json:"units" example:"metric"`
 	WeatherOverview string  `json:"weather_overview" example:"clear sky"`
+	Provider        string  `json:"provider,omitempty" example:"openweather"`
+}
+
+// ForecastSlotData describes the expected conditions for one forecast interval.
+type ForecastSlotData struct {
+	Time                     time.Time `json:"time"`
+	Temperature              float64   `json:"temperature" example:"15.5"`
+	FeelsLike                float64   `json:"feels_like" example:"14.8"`
+	TempMin                  float64   `json:"temp_min" example:"14.2"`
+	TempMax                  float64   `json:"temp_max" example:"16.1"`
+	Description              string    `json:"description" example:"light rain"`
+	Humidity                 int       `json:"humidity" example:"80"`
+	WindSpeed                float64   `json:"wind_speed" example:"4.5"`
+	PrecipitationProbability float64   `json:"precipitation_probability" example:"0.35"`
+}
+
+// ForecastData is a time-ordered forecast for a city.
+type ForecastData struct {
+	City     string             `json:"city" example:"London"`
+	Country  string             `json:"country" example:"GB"`
+	Lat      float32            `json:"lat" example:"51.5085"`
+	Lon      float32            `json:"lon" example:"-0.1257"`
+	Slots    []ForecastSlotData `json:"slots"`
+	Units    string             `json:"units" example:"metric"`
+	Lang     string             `json:"lang" example:"en"`
+	Provider string             `json:"provider,omitempty" example:"openweather"`
+}
+
+// CurrentConditionsData describes the observed weather in a One Call response.
+type CurrentConditionsData struct {
+	Time        time.Time `json:"time"`
+	Sunrise     time.Time `json:"sunrise"`
+	Sunset      time.Time `json:"sunset"`
+	Temperature float64   `json:"temperature" example:"15.5"`
+	FeelsLike   float64   `json:"feels_like" example:"14.8"`
+	Pressure    int       `json:"pressure" example:"1015"`
+	Humidity    int       `json:"humidity" example:"80"`
+	DewPoint    float64   `json:"dew_point" example:"9.1"`
+	UVIndex     float64   `json:"uv_index" example:"2.3"`
+	Clouds      int       `json:"clouds" example:"40"`
+	Visibility  int       `json:"visibility" example:"10000"`
+	WindSpeed   float64   `json:"wind_speed" example:"4.5"`
+	WindDeg     int       `json:"wind_deg" example:"220"`
+	Description string    `json:"description" example:"scattered clouds"`
+}
+
+// MinutelyPrecipitationData is the expected precipitation (mm/h) for one minute.
+type MinutelyPrecipitationData struct {
+	Time          time.Time `json:"time"`
+	Precipitation float64   `json:"precipitation" example:"0.25"`
+}
+
+// HourlyConditionsData is the forecast for a single hour.
+type HourlyConditionsData struct {
+	Time                     time.Time `json:"time"`
+	Temperature              float64   `json:"temperature" example:"15.5"`
+	FeelsLike                float64   `json:"feels_like" example:"14.8"`
+	Humidity                 int       `json:"humidity" example:"80"`
+	WindSpeed                float64   `json:"wind_speed" example:"4.5"`
+	Description              string    `json:"description" example:"light rain"`
+	PrecipitationProbability float64   `json:"precipitation_probability" example:"0.35"`
+}
+
+// DailyConditionsData is the forecast for a single day.
+type DailyConditionsData struct {
+	Date                     time.Time `json:"date"`
+	Summary                  string    `json:"summary" example:"Expect a day of partly cloudy with rain"`
+	TempMin                  float64   `json:"temp_min" example:"9.2"`
+	TempMax                  float64   `json:"temp_max" example:"16.4"`
+	TempDay                  float64   `json:"temp_day" example:"15.1"`
+	TempNight                float64   `json:"temp_night" example:"10.3"`
+	Humidity                 int       `json:"humidity" example:"72"`
+	WindSpeed                float64   `json:"wind_speed" example:"5.2"`
+	Description              string    `json:"description" example:"light rain"`
+	PrecipitationProbability float64   `json:"precipitation_probability" example:"0.6"`
+	Rain                     float64   `json:"rain" example:"2.4"`
+	UVIndex                  float64   `json:"uv_index" example:"3.1"`
+}
+
+// WeatherAlertData is a warning issued by a national weather agency.
+type WeatherAlertData struct {
+	SenderName  string    `json:"sender_name" example:"NWS Philadelphia - Mount Holly"`
+	Event       string    `json:"event" example:"Small Craft Advisory"`
+	Start       time.Time `json:"start"`
+	End         time.Time `json:"end"`
+	Description string    `json:"description" example:"Winds 15 to 20 kt with gusts up to 25 kt."`
+	Tags        []string  `json:"tags"`
+	Severity    string    `json:"severity" example:"moderate" enums:"unknown,minor,moderate,severe,extreme"`
+}
+
+// OneCallData contains the requested One Call blocks; excluded blocks are omitted.
+type OneCallData struct {
+	Lat            float32                     `json:"lat" example:"38.4"`
+	Lon            float32                     `json:"lon" example:"27.1"`
+	Timezone       string                      `json:"timezone" example:"Europe/Istanbul"`
+	TimezoneOffset int                         `json:"timezone_offset" example:"10800"`
+	Current        *CurrentConditionsData      `json:"current,omitempty"`
+	Minutely       []MinutelyPrecipitationData `json:"minutely,omitempty"`
+	Hourly         []HourlyConditionsData      `json:"hourly,omitempty"`
+	Daily          []DailyConditionsData       `json:"daily,omitempty"`
+	Alerts         []WeatherAlertData          `json:"alerts,omitempty"`
+	Units          string                      `json:"units" example:"metric"`
+	Lang           string                      `json:"lang" example:"en"`
+	Provider       string                      `json:"provider,omitempty" example:"openweather"`
+}
+
+// AirComponentsData holds pollutant concentrations in μg/m3.
+type AirComponentsData struct {
+	CO    float64 `json:"co" example:"201.94"`
+	NO    float64 `json:"no" example:"0.02"`
+	NO2   float64 `json:"no2" example:"0.77"`
+	O3    float64 `json:"o3" example:"68.66"`
+	SO2   float64 `json:"so2" example:"0.64"`
+	PM2_5 float64 `json:"pm2_5" example:"0.5"`
+	PM10  float64 `json:"pm10" example:"0.54"`
+	NH3   float64 `json:"nh3" example:"0.12"`
+}
+
+// AirQualityReadingData is one air quality measurement or forecast point.
+type AirQualityReadingData struct {
+	Time       time.Time         `json:"time"`
+	AQI        int               `json:"aqi" example:"2"`
+	AQILabel   string            `json:"aqi_label" example:"fair"`
+	Components AirComponentsData `json:"components"`
+}
+
+// AirQualityData is a series of air quality readings for a coordinate.
+type AirQualityData struct {
+	Lat      float32                 `json:"lat" example:"38.4"`
+	Lon      float32                 `json:"lon" example:"27.1"`
+	Readings []AirQualityReadingData `json:"readings"`
+	Provider string                  `json:"provider,omitempty" example:"openweather"`
+}
+
+// HistoricalObservationData is the recorded weather at a single point in time.
+type HistoricalObservationData struct {
+	Time        time.Time `json:"time"`
+	Temperature float64   `json:"temperature" example:"12.4"`
+	FeelsLike   float64   `json:"feels_like" example:"11.2"`
+	Pressure    int       `json:"pressure" example:"1012"`
+	Humidity    int       `json:"humidity" example:"76"`
+	DewPoint    float64   `json:"dew_point" example:"8.3"`
+	Clouds      int       `json:"clouds" example:"75"`
+	Visibility  int       `json:"visibility" example:"10000"`
+	WindSpeed   float64   `json:"wind_speed" example:"5.1"`
+	WindDeg     int       `json:"wind_deg" example:"240"`
+	Description string    `json:"description" example:"broken clouds"`
+}
+
+// HistoricalWeatherData holds the observations recorded at a past timestamp.
+type HistoricalWeatherData struct {
+	Lat          float32                     `json:"lat" example:"38.4"`
+	Lon          float32                     `json:"lon" example:"27.1"`
+	Timezone     string                      `json:"timezone" example:"Europe/Istanbul"`
+	Observations []HistoricalObservationData `json:"observations"`
+	Units        string                      `json:"units" example:"metric"`
+	Lang         string                      `json:"lang" example:"en"`
+	Provider     string                      `json:"provider,omitempty" example:"openweather"`
+}
+
+// DaySummaryData aggregates the weather recorded over one calendar day.
+type DaySummaryData struct {
+	Date                string  `json:"date" example:"2024-01-15"`
+	TempMin             float64 `json:"temp_min" example:"4.2"`
+	TempMax             float64 `json:"temp_max" example:"11.8"`
+	TempMorning         float64 `json:"temp_morning" example:"5.1"`
+	TempAfternoon       float64 `json:"temp_afternoon" example:"11.2"`
+	TempEvening         float64 `json:"temp_evening" example:"8.4"`
+	TempNight           float64 `json:"temp_night" example:"5.9"`
+	HumidityAfternoon   float64 `json:"humidity_afternoon" example:"62"`
+	CloudCoverAfternoon float64 `json:"cloud_cover_afternoon" example:"40"`
+	PressureAfternoon   float64 `json:"pressure_afternoon" example:"1016"`
+	Precipitation       float64 `json:"precipitation" example:"1.6"`
+	WindMaxSpeed        float64 `json:"wind_max_speed" example:"8.7"`
+	WindMaxDirection    float64 `json:"wind_max_direction" example:"250"`
+}
+
+// HistoricalRangeData is a series of day summaries with totals over the whole range.
+type HistoricalRangeData struct {
+	Lat                float32          `json:"lat" example:"38.4"`
+	Lon                float32          `json:"lon" example:"27.1"`
+	From               string           `json:"from" example:"2024-01-15"`
+	To                 string           `json:"to" example:"2024-01-21"`
+	TempMin            float64          `json:"temp_min" example:"1.9"`
+	TempMax            float64          `json:"temp_max" example:"13.4"`
+	TempMean           float64          `json:"temp_mean" example:"7.6"`
+	TotalPrecipitation float64          `json:"total_precipitation" example:"12.3"`
+	MaxWindSpeed       float64          `json:"max_wind_speed" example:"11.2"`
+	Days               []DaySummaryData `json:"days"`
+	Units              string           `json:"units" example:"metric"`
 }
 
 // WeatherResponse is the generic response wrapper for the weather API.
@@ -34,3 +238,45 @@ type WeatherOverviewResponse struct {
 	Data    *WeatherOverviewData `json:"data,omitempty"`
 	Error   string               `json:"error,omitempty" example:"lat lon not found"`
 }
+
+// ForecastResponse is the response wrapper for the forecast endpoint.
+type ForecastResponse struct {
+	Success bool          `json:"success" example:"true"`
+	Data    *ForecastData `json:"data,omitempty"`
+	Error   string        `json:"error,omitempty" example:"city not found"`
+}
+
+// OneCallResponse is the response wrapper for the One Call endpoint.
+type OneCallResponse struct {
+	Success bool         `json:"success" example:"true"`
+	Data    *OneCallData `json:"data,omitempty"`
+	Error   string       `json:"error,omitempty" example:"unknown include block"`
+}
+
+// AirQualityResponse is the response wrapper for the air quality endpoints.
+type AirQualityResponse struct {
+	Success bool            `json:"success" example:"true"`
+	Data    *AirQualityData `json:"data,omitempty"`
+	Error   string          `json:"error,omitempty" example:"end must be after start"`
+}
+
+// HistoricalWeatherResponse is the response wrapper for the point-in-time history endpoint.
+type HistoricalWeatherResponse struct {
+	Success bool                   `json:"success" example:"true"`
+	Data    *HistoricalWeatherData `json:"data,omitempty"`
+	Error   string                 `json:"error,omitempty" example:"at must be an RFC3339 timestamp"`
+}
+
+// HistoricalRangeResponse is the response wrapper for the date-range history endpoint.
+type HistoricalRangeResponse struct {
+	Success bool                 `json:"success" example:"true"`
+	Data    *HistoricalRangeData `json:"data,omitempty"`
+	Error   string               `json:"error,omitempty" example:"range must span between 1 and 31 days"`
+}
+
+// AlertsResponse is the response wrapper for the alerts endpoint.
+type AlertsResponse struct {
+	Success bool               `json:"success" example:"true"`
+	Data    []WeatherAlertData `json:"data"`
+	Error   string             `json:"error,omitempty" example:"unknown min_severity 'high'"`
+}
@@ -0,0 +1,227 @@
+package keystore
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"weather-api/internal/core/domain/entity"
+	"weather-api/internal/core/domain/repository"
+)
+
+// FileStore keeps API keys in a JSON file readable only by its owner. The file is re-read whenever
+// it changes on disk, so keys created or revoked by the apikey CLI take effect without a restart.
+type FileStore struct {
+	path string
+
+	mu      sync.Mutex
+	keys    []fileRecord
+	modTime time.Time
+	size    int64
+}
+
+type fileDocument struct {
+	Keys []fileRecord `json:"keys"`
+}
+
+type fileRecord struct {
+	ID        string               `json:"id"`
+	Name      string               `json:"name"`
+	Prefix    string               `json:"prefix"`
+	Hash      string               `json:"hash"`
+	Scopes    []entity.APIKeyScope `json:"scopes"`
+	CreatedAt time.Time            `json:"created_at"`
+	ExpiresAt *time.Time           `json:"expires_at,omitempty"`
+	RevokedAt *time.Time           `json:"revoked_at,omitempty"`
+}
+
+// NewFileStore opens the key file at path. A missing file is treated as an empty store and is created,
+// along with its directory, on the first write.
+func NewFileStore(path string) (*FileStore, error) {
+	store := &FileStore{path: path}
+	if err := store.refresh(); err != nil {
+		return nil, err
+	}
+	return store, nil
+}
+
+// CreateAPIKey appends key to the file.
+func (s *FileStore) CreateAPIKey(ctx context.Context, key entity.APIKey) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.refresh(); err != nil {
+		return err
+	}
+	for _, record := range s.keys {
+		if record.ID == key.ID || record.Hash == key.Hash {
+			return repository.ErrAPIKeyExists
+		}
+	}
+
+	keys := append(append([]fileRecord(nil), s.keys...), toFileRecord(key))
+	return s.save(keys)
+}
+
+// FindAPIKeyByHash returns the key stored under hash.
+func (s *FileStore) FindAPIKeyByHash(ctx context.Context, hash string) (*entity.APIKey, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.refresh(); err != nil {
+		return nil, err
+	}
+	for _, record := range s.keys {
+		if record.Hash == hash {
+			key := record.toEntity()
+			return &key, nil
+		}
+	}
+	return nil, repository.ErrAPIKeyNotFound
+}
+
+// ListAPIKeys returns every stored key in creation order.
+func (s *FileStore) ListAPIKeys(ctx context.Context) ([]entity.APIKey, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.refresh(); err != nil {
+		return nil, err
+	}
+	keys := make([]entity.APIKey, len(s.keys))
+	for i, record := range s.keys {
+		keys[i] = record.toEntity()
+	}
+	return keys, nil
+}
+
+// RevokeAPIKey marks the key with the given ID as revoked at the given time, unless it already is.
+func (s *FileStore) RevokeAPIKey(ctx context.Context, id string, at time.Time) (*entity.APIKey, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.refresh(); err != nil {
+		return nil, err
+	}
+	for i, record := range s.keys {
+		if record.ID != id {
+			continue
+		}
+		if record.RevokedAt != nil {
+			key := record.toEntity()
+			return &key, nil
+		}
+
+		keys := append([]fileRecord(nil), s.keys...)
+		keys[i].RevokedAt = &at
+		if err := s.save(keys); err != nil {
+			return nil, err
+		}
+		key := keys[i].toEntity()
+		return &key, nil
+	}
+	return nil, repository.ErrAPIKeyNotFound
+}
+
+// Ping reports whether the key file can still be read and parsed. A missing file is an empty store.
+func (s *FileStore) Ping(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.refresh()
+}
+
+// refresh reloads the file if it changed since it was last read. Callers must hold s.mu.
+func (s *FileStore) refresh() error {
+	info, err := os.Stat(s.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		s.keys, s.modTime, s.size = nil, time.Time{}, 0
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("stat api key file: %w", err)
+	}
+	if info.ModTime().Equal(s.modTime) && info.Size() == s.size {
+		return nil
+	}
+
+	data, err := os.ReadFile(s.path)
+	if err != nil {
+		return fmt.Errorf("read api key file: %w", err)
+	}
+	var document fileDocument
+	if err := json.Unmarshal(data, &document); err != nil {
+		return fmt.Errorf("parse api key file %s: %w", s.path, err)
+	}
+
+	s.keys, s.modTime, s.size = document.Keys, info.ModTime(), info.Size()
+	return nil
+}
+
+// save atomically replaces the file with keys. Callers must hold s.mu.
+func (s *FileStore) save(keys []fileRecord) error {
+	data, err := json.MarshalIndent(fileDocument{Keys: keys}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encode api key file: %w", err)
+	}
+
+	dir := filepath.Dir(s.path)
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return fmt.Errorf("create api key directory: %w", err)
+	}
+	tmp, err := os.CreateTemp(dir, filepath.Base(s.path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("write api key file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("write api key file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("write api key file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), s.path); err != nil {
+		return fmt.Errorf("replace api key file: %w", err)
+	}
+
+	info, err := os.Stat(s.path)
+	if err != nil {
+		return fmt.Errorf("stat api key file: %w", err)
+	}
+	s.keys, s.modTime, s.size = keys, info.ModTime(), info.Size()
+	return nil
+}
+
+func toFileRecord(key entity.APIKey) fileRecord {
+	return fileRecord{
+		ID:        key.ID,
+		Name:      key.Name,
+		Prefix:    key.Prefix,
+		Hash:      key.Hash,
+		Scopes:    key.Scopes,
+		CreatedAt: key.CreatedAt,
+		ExpiresAt: key.ExpiresAt,
+		RevokedAt: key.RevokedAt,
+	}
+}
+
+func (r fileRecord) toEntity() entity.APIKey {
+	return entity.APIKey{
+		ID:        r.ID,
+		Name:      r.Name,
+		Prefix:    r.Prefix,
+		Hash:      r.Hash,
+		Scopes:    append([]entity.APIKeyScope(nil), r.Scopes...),
+		CreatedAt: r.CreatedAt,
+		ExpiresAt: r.ExpiresAt,
+		RevokedAt: r.RevokedAt,
+	}
+}
@@ -0,0 +1,126 @@
+package keystore
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"weather-api/internal/core/domain/entity"
+	"weather-api/internal/core/domain/repository"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestKey(id string) entity.APIKey {
+	return entity.APIKey{
+		ID:        id,
+		Name:      "key " + id,
+		Prefix:    "wapi_abc",
+		Hash:      "hash-" + id,
+		Scopes:    []entity.APIKeyScope{entity.ScopeWeatherRead},
+		CreatedAt: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestFileStore_CreateFindAndList(t *testing.T) {
+	// Arrange
+	path := filepath.Join(t.TempDir(), "keys", "api_keys.json")
+	store, err := NewFileStore(path)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	// Act
+	assert.NoError(t, store.CreateAPIKey(context.Background(), newTestKey("a")))
+	assert.NoError(t, store.CreateAPIKey(context.Background(), newTestKey("b")))
+
+	// Assert
+	found, err := store.FindAPIKeyByHash(context.Background(), "hash-b")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "b", found.ID)
+		assert.Equal(t, []entity.APIKeyScope{entity.ScopeWeatherRead}, found.Scopes)
+	}
+
+	keys, err := store.ListAPIKeys(context.Background())
+	assert.NoError(t, err)
+	if assert.Len(t, keys, 2) {
+		assert.Equal(t, "a", keys[0].ID)
+	}
+
+	info, err := os.Stat(path)
+	if assert.NoError(t, err) {
+		assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+	}
+
+	_, err = store.FindAPIKeyByHash(context.Background(), "unknown")
+	assert.ErrorIs(t, err, repository.ErrAPIKeyNotFound)
+	assert.ErrorIs(t, store.CreateAPIKey(context.Background(), newTestKey("a")), repository.ErrAPIKeyExists)
+}
+
+func TestFileStore_RevokeSeenByOtherStore(t *testing.T) {
+	// Arrange
+	path := filepath.Join(t.TempDir(), "api_keys.json")
+	server, err := NewFileStore(path)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.NoError(t, server.CreateAPIKey(context.Background(), newTestKey("a")))
+
+	// A second store on the same file stands in for the apikey CLI.
+	cli, err := NewFileStore(path)
+	if !assert.NoError(t, err) {
+		return
+	}
+	revokedAt := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	// Act
+	revoked, err1 := cli.RevokeAPIKey(context.Background(), "a", revokedAt)
+	again, err2 := cli.RevokeAPIKey(context.Background(), "a", revokedAt.Add(time.Hour))
+	found, err3 := server.FindAPIKeyByHash(context.Background(), "hash-a")
+
+	// Assert
+	if assert.NoError(t, err1) {
+		assert.True(t, revoked.Revoked())
+	}
+	if assert.NoError(t, err2) {
+		assert.Equal(t, revokedAt, *again.RevokedAt, "revoking twice keeps the first revocation time")
+	}
+	if assert.NoError(t, err3) {
+		assert.True(t, found.Revoked(), "the server store should pick up the CLI's change")
+	}
+
+	_, err = cli.RevokeAPIKey(context.Background(), "missing", revokedAt)
+	assert.ErrorIs(t, err, repository.ErrAPIKeyNotFound)
+}
+
+func TestNewFileStore_InvalidFile(t *testing.T) {
+	// Arrange
+	path := filepath.Join(t.TempDir(), "api_keys.json")
+	assert.NoError(t, os.WriteFile(path, []byte("not json"), 0o600))
+
+	// Act
+	_, err := NewFileStore(path)
+
+	// Assert
+	assert.Error(t, err)
+}
+
+func TestFileStore_Ping(t *testing.T) {
+	// Arrange
+	path := filepath.Join(t.TempDir(), "api_keys.json")
+	store, err := NewFileStore(path)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	// Act
+	missing := store.Ping(context.Background())
+	assert.NoError(t, os.WriteFile(path, []byte("not json"), 0o600))
+	corrupted := store.Ping(context.Background())
+
+	// Assert
+	assert.NoError(t, missing, "a missing file is an empty store")
+	assert.ErrorContains(t, corrupted, "parse api key file")
+}
@@ -0,0 +1,284 @@
+package weather
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"weather-api/internal/core/domain/entity"
+	"weather-api/internal/core/domain/repository"
+	"weather-api/internal/infrastructure/config"
+	"weather-api/internal/infrastructure/support"
+	"weather-api/pkg/cache"
+	"weather-api/pkg/language"
+	"weather-api/pkg/units"
+)
+
+// Cache operation names used for keys, TTL lookup and statistics.
+const (
+	cacheOpCurrent            = "current"
+	cacheOpCurrentCoordinates = "current_coordinates"
+	cacheOpOverview           = "overview"
+	cacheOpForecast           = "forecast"
+	cacheOpOneCall            = "onecall"
+	cacheOpAirQuality         = "air_quality"
+	cacheOpAirQualityForecast = "air_quality_forecast"
+	cacheOpAirQualityHistory  = "air_quality_history"
+	cacheOpHistorical         = "historical"
+	cacheOpDaySummary         = "day_summary"
+)
+
+// CachedWeatherRepository decorates a WeatherRepository with an in-process LRU cache.
+// Cached values are shared between callers and must be treated as read-only.
+// Entries are kept for staleTTL past their TTL and answered again, instead of the error, when a
+// refresh is refused because the upstream call quota is used up.
+type CachedWeatherRepository struct {
+	next     repository.WeatherRepository
+	store    *cache.LRU[string, cacheEntry]
+	ttls     map[string]time.Duration
+	staleTTL time.Duration
+	now      func() time.Time
+
+	mu    sync.Mutex
+	stats map[string]*operationCounters
+}
+
+// cacheEntry is a stored value and the time until which it is fresh.
+type cacheEntry struct {
+	value      interface{}
+	freshUntil time.Time
+}
+
+type operationCounters struct {
+	hits   atomic.Uint64
+	misses atomic.Uint64
+	stale  atomic.Uint64
+}
+
+// CacheStats is a point-in-time snapshot of cache counters. Stale counts misses that were answered
+// with an expired entry because the upstream quota was exhausted.
+type CacheStats struct {
+	Entries    int
+	Hits       uint64
+	Misses     uint64
+	Stale      uint64
+	Operations map[string]CacheOperationStats
+}
+
+// CacheOperationStats holds the counters for a single repository operation.
+type CacheOperationStats struct {
+	Hits   uint64
+	Misses uint64
+	Stale  uint64
+}
+
+// HitRatio returns hits / (hits + misses), or 0 when nothing has been looked up yet.
+func (s CacheStats) HitRatio() float64 {
+	total := s.Hits + s.Misses
+	if total == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(total)
+}
+
+// NewCachedWeatherRepository wraps next with a cache configured by cfg.
+func NewCachedWeatherRepository(next repository.WeatherRepository, cfg config.CacheConfig) *CachedWeatherRepository {
+	return &CachedWeatherRepository{
+		next:  next,
+		store: cache.NewLRU[string, cacheEntry](cfg.MaxEntries),
+		ttls: map[string]time.Duration{
+			cacheOpCurrent:            cfg.CurrentTTL,
+			cacheOpCurrentCoordinates: cfg.CurrentTTL,
+			cacheOpOverview:           cfg.OverviewTTL,
+			cacheOpForecast:           cfg.ForecastTTL,
+			cacheOpOneCall:            cfg.OneCallTTL,
+			cacheOpAirQuality:         cfg.AirQualityTTL,
+			cacheOpAirQualityForecast: cfg.AirQualityTTL,
+			cacheOpAirQualityHistory:  cfg.HistoryTTL,
+			cacheOpHistorical:         cfg.HistoryTTL,
+			cacheOpDaySummary:         cfg.HistoryTTL,
+		},
+		staleTTL: cfg.StaleTTL,
+		now:      time.Now,
+		stats:    make(map[string]*operationCounters),
+	}
+}
+
+func (r *CachedWeatherRepository) GetWeatherByCity(ctx context.Context, city string, lang language.Code) (*entity.Weather, error) {
+	return cached(r, cacheOpCurrent, cityKey(city)+"|"+languageKey(lang), func() (*entity.Weather, error) {
+		return r.next.GetWeatherByCity(ctx, city, lang)
+	})
+}
+
+func (r *CachedWeatherRepository) GetWeatherByCoordinates(ctx context.Context, lat float32, lon float32, lang language.Code) (*entity.Weather, error) {
+	return cached(r, cacheOpCurrentCoordinates, coordKey(lat, lon)+"|"+languageKey(lang), func() (*entity.Weather, error) {
+		return r.next.GetWeatherByCoordinates(ctx, lat, lon, lang)
+	})
+}
+
+func (r *CachedWeatherRepository) GetWeatherOverviewByLatLong(ctx context.Context, lon float32, lat float32, system units.System) (*entity.WeatherOverview, error) {
+	return cached(r, cacheOpOverview, coordKey(lat, lon)+"|"+string(system.OrMetric()), func() (*entity.WeatherOverview, error) {
+		return r.next.GetWeatherOverviewByLatLong(ctx, lon, lat, system)
+	})
+}
+
+func (r *CachedWeatherRepository) GetForecastByCity(ctx context.Context, city string, lang language.Code) (*entity.Forecast, error) {
+	return cached(r, cacheOpForecast, cityKey(city)+"|"+languageKey(lang), func() (*entity.Forecast, error) {
+		return r.next.GetForecastByCity(ctx, city, lang)
+	})
+}
+
+func (r *CachedWeatherRepository) GetOneCall(ctx context.Context, lat float32, lon float32, include []entity.OneCallBlock, lang language.Code) (*entity.OneCall, error) {
+	key := coordKey(lat, lon) + "|" + includeKey(include) + "|" + languageKey(lang)
+	return cached(r, cacheOpOneCall, key, func() (*entity.OneCall, error) {
+		return r.next.GetOneCall(ctx, lat, lon, include, lang)
+	})
+}
+
+func (r *CachedWeatherRepository) GetAirQuality(ctx context.Context, lat float32, lon float32) (*entity.AirQuality, error) {
+	return cached(r, cacheOpAirQuality, coordKey(lat, lon), func() (*entity.AirQuality, error) {
+		return r.next.GetAirQuality(ctx, lat, lon)
+	})
+}
+
+func (r *CachedWeatherRepository) GetAirQualityForecast(ctx context.Context, lat float32, lon float32) (*entity.AirQuality, error) {
+	return cached(r, cacheOpAirQualityForecast, coordKey(lat, lon), func() (*entity.AirQuality, error) {
+		return r.next.GetAirQualityForecast(ctx, lat, lon)
+	})
+}
+
+func (r *CachedWeatherRepository) GetAirQualityHistory(ctx context.Context, lat float32, lon float32, start time.Time, end time.Time) (*entity.AirQuality, error) {
+	key := fmt.Sprintf("%s|%d|%d", coordKey(lat, lon), start.Unix(), end.Unix())
+	return cached(r, cacheOpAirQualityHistory, key, func() (*entity.AirQuality, error) {
+		return r.next.GetAirQualityHistory(ctx, lat, lon, start, end)
+	})
+}
+
+func (r *CachedWeatherRepository) GetHistoricalWeather(ctx context.Context, lat float32, lon float32, at time.Time, lang language.Code) (*entity.HistoricalWeather, error) {
+	key := fmt.Sprintf("%s|%d|%s", coordKey(lat, lon), at.Unix(), languageKey(lang))
+	return cached(r, cacheOpHistorical, key, func() (*entity.HistoricalWeather, error) {
+		return r.next.GetHistoricalWeather(ctx, lat, lon, at, lang)
+	})
+}
+
+func (r *CachedWeatherRepository) GetDaySummary(ctx context.Context, lat float32, lon float32, date time.Time) (*entity.DaySummary, error) {
+	key := coordKey(lat, lon) + "|" + date.Format(daySummaryDateLayout)
+	return cached(r, cacheOpDaySummary, key, func() (*entity.DaySummary, error) {
+		return r.next.GetDaySummary(ctx, lat, lon, date)
+	})
+}
+
+// Stats returns a snapshot of the hit and miss counters, overall and per operation.
+func (r *CachedWeatherRepository) Stats() CacheStats {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stats := CacheStats{
+		Entries:    r.store.Len(),
+		Operations: make(map[string]CacheOperationStats, len(r.stats)),
+	}
+	for op, counters := range r.stats {
+		opStats := CacheOperationStats{Hits: counters.hits.Load(), Misses: counters.misses.Load(), Stale: counters.stale.Load()}
+		stats.Operations[op] = opStats
+		stats.Hits += opStats.Hits
+		stats.Misses += opStats.Misses
+		stats.Stale += opStats.Stale
+	}
+	return stats
+}
+
+func (r *CachedWeatherRepository) counters(op string) *operationCounters {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	counters, ok := r.stats[op]
+	if !ok {
+		counters = &operationCounters{}
+		r.stats[op] = counters
+	}
+	return counters
+}
+
+// cached returns the fresh cached value for op/key or calls fetch and stores a successful result.
+// A stale value is returned only when fetch fails with support.ErrQuotaExceeded.
+// Operations with a non-positive TTL bypass the cache.
+func cached[T any](r *CachedWeatherRepository, op string, key string, fetch func() (T, error)) (T, error) {
+	ttl := r.ttls[op]
+	if ttl <= 0 {
+		return fetch()
+	}
+
+	counters := r.counters(op)
+	fullKey := op + "|" + key
+	stored, found := r.store.Get(fullKey)
+	if found && r.now().Before(stored.freshUntil) {
+		if typed, ok := stored.value.(T); ok {
+			counters.hits.Add(1)
+			return typed, nil
+		}
+	}
+	counters.misses.Add(1)
+
+	value, err := fetch()
+	if err != nil {
+		var quotaErr *support.ErrQuotaExceeded
+		if found && errors.As(err, &quotaErr) {
+			if typed, ok := stored.value.(T); ok {
+				counters.stale.Add(1)
+				return typed, nil
+			}
+		}
+		return value, err
+	}
+
+	r.store.Set(fullKey, cacheEntry{value: value, freshUntil: r.now().Add(ttl)}, ttl+r.staleTTL)
+	return value, nil
+}
+
+// cityKey normalizes a city name so that "  new   York" and "New York" share an entry.
+func cityKey(city string) string {
+	return strings.ToLower(strings.Join(strings.Fields(city), " "))
+}
+
+// coordKey rounds coordinates to two decimals (roughly 1 km) so nearby lookups share an entry.
+func coordKey(lat float32, lon float32) string {
+	return fmt.Sprintf("%.2f,%.2f", roundCoord(lat), roundCoord(lon))
+}
+
+// roundCoord rounds to two decimals; adding zero folds -0 into 0 so both sides of the
+// equator and prime meridian produce the same key.
+func roundCoord(value float32) float64 {
+	return math.Round(float64(value)*100)/100 + 0
+}
+
+// languageKey folds an empty language into the default so both share an entry.
+func languageKey(lang language.Code) string {
+	return string(lang.OrDefault())
+}
+
+// includeKey builds an order-independent key for a One Call include list.
+func includeKey(include []entity.OneCallBlock) string {
+	if len(include) == 0 {
+		return "all"
+	}
+
+	wanted := make(map[entity.OneCallBlock]bool, len(include))
+	for _, block := range include {
+		wanted[block] = true
+	}
+
+	var parts []string
+	for _, block := range entity.AllOneCallBlocks() {
+		if wanted[block] {
+			parts = append(parts, string(block))
+		}
+	}
+	return strings.Join(parts, ",")
+}
+
+var _ repository.WeatherRepository = (*CachedWeatherRepository)(nil)
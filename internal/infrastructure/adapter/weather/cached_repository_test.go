@@ -0,0 +1,227 @@
+package weather
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"weather-api/internal/core/domain/entity"
+	"weather-api/internal/infrastructure/config"
+	"weather-api/internal/infrastructure/support"
+	"weather-api/pkg/language"
+	"weather-api/pkg/units"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testCacheConfig() config.CacheConfig {
+	return config.CacheConfig{
+		Enabled:       true,
+		MaxEntries:    10,
+		CurrentTTL:    time.Minute,
+		OverviewTTL:   time.Minute,
+		ForecastTTL:   time.Minute,
+		OneCallTTL:    time.Minute,
+		AirQualityTTL: time.Minute,
+		HistoryTTL:    time.Hour,
+	}
+}
+
+func TestCachedWeatherRepository_GetWeatherByCity_HitsCache(t *testing.T) {
+	// Arrange
+	stub := &stubWeatherRepository{}
+	repo := NewCachedWeatherRepository(stub, testCacheConfig())
+
+	// Act
+	first, err1 := repo.GetWeatherByCity(context.Background(), "London", language.English)
+	second, err2 := repo.GetWeatherByCity(context.Background(), "  london ", language.English)
+
+	// Assert
+	assert.NoError(t, err1)
+	assert.NoError(t, err2)
+	assert.Same(t, first, second)
+	assert.Equal(t, int64(1), stub.calls.Load())
+
+	stats := repo.Stats()
+	assert.Equal(t, uint64(1), stats.Hits)
+	assert.Equal(t, uint64(1), stats.Misses)
+	assert.Equal(t, uint64(1), stats.Operations[cacheOpCurrent].Hits)
+	assert.Equal(t, 0.5, stats.HitRatio())
+	assert.Equal(t, 1, stats.Entries)
+}
+
+func TestCachedWeatherRepository_DoesNotCacheErrors(t *testing.T) {
+	// Arrange
+	stub := &stubWeatherRepository{
+		weatherFn: func(ctx context.Context, city string) (*entity.Weather, error) {
+			return nil, support.NewErrNotFound("city not found")
+		},
+	}
+	repo := NewCachedWeatherRepository(stub, testCacheConfig())
+
+	// Act
+	_, err1 := repo.GetWeatherByCity(context.Background(), "Atlantis", language.English)
+	_, err2 := repo.GetWeatherByCity(context.Background(), "Atlantis", language.English)
+
+	// Assert
+	assert.Error(t, err1)
+	assert.Error(t, err2)
+	assert.Equal(t, int64(2), stub.calls.Load())
+	assert.Equal(t, uint64(2), repo.Stats().Misses)
+}
+
+func TestCachedWeatherRepository_RoundsCoordinates(t *testing.T) {
+	// Arrange
+	stub := &stubWeatherRepository{}
+	repo := NewCachedWeatherRepository(stub, testCacheConfig())
+
+	// Act
+	_, _ = repo.GetAirQuality(context.Background(), 51.5071, -0.1278)
+	_, _ = repo.GetAirQuality(context.Background(), 51.5069, -0.1281)
+	_, _ = repo.GetAirQuality(context.Background(), 51.5200, -0.1278)
+
+	// Assert
+	assert.Equal(t, int64(2), stub.calls.Load())
+}
+
+func TestCachedWeatherRepository_OneCallKeyIgnoresIncludeOrder(t *testing.T) {
+	// Arrange
+	stub := &stubWeatherRepository{}
+	repo := NewCachedWeatherRepository(stub, testCacheConfig())
+
+	// Act
+	_, _ = repo.GetOneCall(context.Background(), 40.7, -74, []entity.OneCallBlock{entity.OneCallDaily, entity.OneCallHourly}, language.English)
+	_, _ = repo.GetOneCall(context.Background(), 40.7, -74, []entity.OneCallBlock{entity.OneCallHourly, entity.OneCallDaily}, language.English)
+	_, _ = repo.GetOneCall(context.Background(), 40.7, -74, nil, language.English)
+
+	// Assert
+	assert.Equal(t, int64(2), stub.calls.Load())
+}
+
+func TestCachedWeatherRepository_OverviewKeyIncludesUnits(t *testing.T) {
+	// Arrange
+	stub := &stubWeatherRepository{}
+	repo := NewCachedWeatherRepository(stub, testCacheConfig())
+
+	// Act
+	_, _ = repo.GetWeatherOverviewByLatLong(context.Background(), 27.1, 38.4, "")
+	_, _ = repo.GetWeatherOverviewByLatLong(context.Background(), 27.1, 38.4, units.Metric)
+	_, _ = repo.GetWeatherOverviewByLatLong(context.Background(), 27.1, 38.4, units.Imperial)
+
+	// Assert
+	assert.Equal(t, int64(2), stub.calls.Load())
+}
+
+func TestCachedWeatherRepository_KeysOnLanguage(t *testing.T) {
+	// Arrange
+	stub := &stubWeatherRepository{}
+	repo := NewCachedWeatherRepository(stub, testCacheConfig())
+
+	// Act
+	english, _ := repo.GetWeatherByCity(context.Background(), "Berlin", "")
+	_, _ = repo.GetWeatherByCity(context.Background(), "Berlin", language.English)
+	german, _ := repo.GetWeatherByCity(context.Background(), "Berlin", "de")
+
+	// Assert
+	assert.Equal(t, int64(2), stub.calls.Load())
+	assert.Equal(t, language.Code(""), english.Language)
+	assert.Equal(t, language.Code("de"), german.Language)
+}
+
+func TestCachedWeatherRepository_ZeroTTLBypassesCache(t *testing.T) {
+	// Arrange
+	cfg := testCacheConfig()
+	cfg.ForecastTTL = 0
+	stub := &stubWeatherRepository{}
+	repo := NewCachedWeatherRepository(stub, cfg)
+
+	// Act
+	_, _ = repo.GetForecastByCity(context.Background(), "Paris", language.English)
+	_, _ = repo.GetForecastByCity(context.Background(), "Paris", language.English)
+
+	// Assert
+	assert.Equal(t, int64(2), stub.calls.Load())
+	assert.Equal(t, 0, repo.Stats().Entries)
+}
+
+func TestCachedWeatherRepository_SeparatesOperations(t *testing.T) {
+	// Arrange
+	stub := &stubWeatherRepository{
+		daySummaryFn: func(ctx context.Context, lat float32, lon float32, date time.Time) (*entity.DaySummary, error) {
+			if date.Day() == 2 {
+				return nil, errors.New("boom")
+			}
+			return &entity.DaySummary{Date: date}, nil
+		},
+	}
+	repo := NewCachedWeatherRepository(stub, testCacheConfig())
+	day := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// Act
+	_, _ = repo.GetDaySummary(context.Background(), 38.4, 27.1, day)
+	_, _ = repo.GetDaySummary(context.Background(), 38.4, 27.1, day.Add(6*time.Hour))
+	_, err := repo.GetDaySummary(context.Background(), 38.4, 27.1, day.AddDate(0, 0, 1))
+	_, _ = repo.GetHistoricalWeather(context.Background(), 38.4, 27.1, day, language.English)
+
+	// Assert
+	assert.Error(t, err)
+	assert.Equal(t, int64(3), stub.calls.Load())
+	assert.Equal(t, uint64(1), repo.Stats().Operations[cacheOpDaySummary].Hits)
+	assert.Equal(t, uint64(1), repo.Stats().Operations[cacheOpHistorical].Misses)
+}
+
+func TestCachedWeatherRepository_ServesStaleWhenQuotaExhausted(t *testing.T) {
+	// Arrange
+	var refuse bool
+	stub := &stubWeatherRepository{
+		weatherFn: func(ctx context.Context, city string) (*entity.Weather, error) {
+			if refuse {
+				return nil, support.NewErrQuotaExceeded("openweather 2.5 daily quota of 1 calls exhausted, retry in 1h0m0s", time.Hour)
+			}
+			return &entity.Weather{City: city}, nil
+		},
+	}
+	cfg := testCacheConfig()
+	cfg.StaleTTL = time.Hour
+	repo := NewCachedWeatherRepository(stub, cfg)
+	now := time.Now()
+	repo.now = func() time.Time { return now }
+
+	// Act
+	fresh, _ := repo.GetWeatherByCity(context.Background(), "London", language.English)
+	now = now.Add(2 * time.Minute)
+	refuse = true
+	stale, staleErr := repo.GetWeatherByCity(context.Background(), "London", language.English)
+	_, missErr := repo.GetWeatherByCity(context.Background(), "Paris", language.English)
+
+	// Assert
+	assert.NoError(t, staleErr)
+	assert.Same(t, fresh, stale)
+	assert.IsType(t, &support.ErrQuotaExceeded{}, missErr, "without a stale entry the quota error is returned")
+	assert.Equal(t, int64(3), stub.calls.Load())
+	assert.Equal(t, uint64(1), repo.Stats().Operations[cacheOpCurrent].Stale)
+}
+
+func TestCachedWeatherRepository_RefreshesExpiredEntries(t *testing.T) {
+	// Arrange
+	stub := &stubWeatherRepository{
+		weatherFn: func(ctx context.Context, city string) (*entity.Weather, error) {
+			return nil, support.NewErrUpstream(http.StatusBadGateway, "bad gateway")
+		},
+	}
+	cfg := testCacheConfig()
+	cfg.StaleTTL = time.Hour
+	repo := NewCachedWeatherRepository(stub, cfg)
+	now := time.Now()
+	repo.now = func() time.Time { return now }
+	repo.store.Set("current|london|en", cacheEntry{value: &entity.Weather{City: "London"}, freshUntil: now}, time.Hour)
+
+	// Act
+	_, err := repo.GetWeatherByCity(context.Background(), "London", language.English)
+
+	// Assert
+	assert.Error(t, err, "stale entries only answer quota errors")
+	assert.Equal(t, int64(1), stub.calls.Load())
+}
@@ -0,0 +1,239 @@
+package weather
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"weather-api/internal/core/domain/entity"
+	"weather-api/internal/core/domain/repository"
+	"weather-api/pkg/language"
+	"weather-api/pkg/units"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// coalesceMaxTrackedKeys bounds the per-key statistics; further keys are folded into coalesceOtherKey.
+const (
+	coalesceMaxTrackedKeys = 1024
+	coalesceOtherKey       = "other"
+)
+
+// CoalescingWeatherRepository collapses concurrent identical requests so that callers asking for
+// the same city or coordinates at the same time share a single upstream fetch and its result.
+type CoalescingWeatherRepository struct {
+	next  repository.WeatherRepository
+	group singleflight.Group
+
+	mu    sync.Mutex
+	calls map[string]*inflightCall
+	stats map[string]*CoalesceKeyStats
+}
+
+// inflightCall carries the context of one shared fetch and how many callers are waiting on it.
+type inflightCall struct {
+	ctx     context.Context
+	cancel  context.CancelFunc
+	waiters int
+}
+
+// CoalesceKeyStats counts how often a key was requested and how many of those callers
+// were served by another caller's in-flight fetch.
+type CoalesceKeyStats struct {
+	Callers   uint64
+	Fetches   uint64
+	Coalesced uint64
+}
+
+// NewCoalescingWeatherRepository wraps next with in-flight request deduplication.
+func NewCoalescingWeatherRepository(next repository.WeatherRepository) *CoalescingWeatherRepository {
+	return &CoalescingWeatherRepository{
+		next:  next,
+		calls: make(map[string]*inflightCall),
+		stats: make(map[string]*CoalesceKeyStats),
+	}
+}
+
+func (r *CoalescingWeatherRepository) GetWeatherByCity(ctx context.Context, city string, lang language.Code) (*entity.Weather, error) {
+	key := cacheOpCurrent + "|" + cityKey(city) + "|" + languageKey(lang)
+	return coalesce(ctx, r, key, func(ctx context.Context) (*entity.Weather, error) {
+		return r.next.GetWeatherByCity(ctx, city, lang)
+	})
+}
+
+func (r *CoalescingWeatherRepository) GetWeatherByCoordinates(ctx context.Context, lat float32, lon float32, lang language.Code) (*entity.Weather, error) {
+	key := cacheOpCurrentCoordinates + "|" + exactCoordKey(lat, lon) + "|" + languageKey(lang)
+	return coalesce(ctx, r, key, func(ctx context.Context) (*entity.Weather, error) {
+		return r.next.GetWeatherByCoordinates(ctx, lat, lon, lang)
+	})
+}
+
+func (r *CoalescingWeatherRepository) GetWeatherOverviewByLatLong(ctx context.Context, lon float32, lat float32, system units.System) (*entity.WeatherOverview, error) {
+	key := cacheOpOverview + "|" + exactCoordKey(lat, lon) + "|" + string(system.OrMetric())
+	return coalesce(ctx, r, key, func(ctx context.Context) (*entity.WeatherOverview, error) {
+		return r.next.GetWeatherOverviewByLatLong(ctx, lon, lat, system)
+	})
+}
+
+func (r *CoalescingWeatherRepository) GetForecastByCity(ctx context.Context, city string, lang language.Code) (*entity.Forecast, error) {
+	key := cacheOpForecast + "|" + cityKey(city) + "|" + languageKey(lang)
+	return coalesce(ctx, r, key, func(ctx context.Context) (*entity.Forecast, error) {
+		return r.next.GetForecastByCity(ctx, city, lang)
+	})
+}
+
+func (r *CoalescingWeatherRepository) GetOneCall(ctx context.Context, lat float32, lon float32, include []entity.OneCallBlock, lang language.Code) (*entity.OneCall, error) {
+	key := cacheOpOneCall + "|" + exactCoordKey(lat, lon) + "|" + includeKey(include) + "|" + languageKey(lang)
+	return coalesce(ctx, r, key, func(ctx context.Context) (*entity.OneCall, error) {
+		return r.next.GetOneCall(ctx, lat, lon, include, lang)
+	})
+}
+
+func (r *CoalescingWeatherRepository) GetAirQuality(ctx context.Context, lat float32, lon float32) (*entity.AirQuality, error) {
+	return coalesce(ctx, r, cacheOpAirQuality+"|"+exactCoordKey(lat, lon), func(ctx context.Context) (*entity.AirQuality, error) {
+		return r.next.GetAirQuality(ctx, lat, lon)
+	})
+}
+
+func (r *CoalescingWeatherRepository) GetAirQualityForecast(ctx context.Context, lat float32, lon float32) (*entity.AirQuality, error) {
+	return coalesce(ctx, r, cacheOpAirQualityForecast+"|"+exactCoordKey(lat, lon), func(ctx context.Context) (*entity.AirQuality, error) {
+		return r.next.GetAirQualityForecast(ctx, lat, lon)
+	})
+}
+
+func (r *CoalescingWeatherRepository) GetAirQualityHistory(ctx context.Context, lat float32, lon float32, start time.Time, end time.Time) (*entity.AirQuality, error) {
+	key := fmt.Sprintf("%s|%s|%d|%d", cacheOpAirQualityHistory, exactCoordKey(lat, lon), start.Unix(), end.Unix())
+	return coalesce(ctx, r, key, func(ctx context.Context) (*entity.AirQuality, error) {
+		return r.next.GetAirQualityHistory(ctx, lat, lon, start, end)
+	})
+}
+
+func (r *CoalescingWeatherRepository) GetHistoricalWeather(ctx context.Context, lat float32, lon float32, at time.Time, lang language.Code) (*entity.HistoricalWeather, error) {
+	key := fmt.Sprintf("%s|%s|%d|%s", cacheOpHistorical, exactCoordKey(lat, lon), at.Unix(), languageKey(lang))
+	return coalesce(ctx, r, key, func(ctx context.Context) (*entity.HistoricalWeather, error) {
+		return r.next.GetHistoricalWeather(ctx, lat, lon, at, lang)
+	})
+}
+
+func (r *CoalescingWeatherRepository) GetDaySummary(ctx context.Context, lat float32, lon float32, date time.Time) (*entity.DaySummary, error) {
+	key := cacheOpDaySummary + "|" + exactCoordKey(lat, lon) + "|" + date.Format(daySummaryDateLayout)
+	return coalesce(ctx, r, key, func(ctx context.Context) (*entity.DaySummary, error) {
+		return r.next.GetDaySummary(ctx, lat, lon, date)
+	})
+}
+
+// Stats returns a snapshot of the per-key caller, fetch and coalesced counters.
+func (r *CoalescingWeatherRepository) Stats() map[string]CoalesceKeyStats {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	snapshot := make(map[string]CoalesceKeyStats, len(r.stats))
+	for key, stats := range r.stats {
+		snapshot[key] = *stats
+	}
+	return snapshot
+}
+
+// record adds one caller for key; fetched reports whether this caller ran the upstream fetch.
+func (r *CoalescingWeatherRepository) record(key string, fetched bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stats, ok := r.stats[key]
+	if !ok {
+		if len(r.stats) >= coalesceMaxTrackedKeys {
+			key = coalesceOtherKey
+			stats, ok = r.stats[key]
+		}
+		if !ok {
+			stats = &CoalesceKeyStats{}
+			r.stats[key] = stats
+		}
+	}
+
+	stats.Callers++
+	if fetched {
+		stats.Fetches++
+	} else {
+		stats.Coalesced++
+	}
+}
+
+// coalesce runs fetch once for all concurrent callers of key and hands each of them the shared result.
+// The shared fetch is detached from any single caller: a caller whose ctx ends stops waiting on its own,
+// and the fetch is canceled only once every caller waiting on it has gone.
+func coalesce[T any](ctx context.Context, r *CoalescingWeatherRepository, key string, fetch func(ctx context.Context) (T, error)) (T, error) {
+	var zero T
+	if err := ctx.Err(); err != nil {
+		return zero, err
+	}
+
+	var fetched atomic.Bool
+
+	r.mu.Lock()
+	call, ok := r.calls[key]
+	if !ok {
+		callCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+		call = &inflightCall{ctx: callCtx, cancel: cancel}
+		r.calls[key] = call
+	}
+	call.waiters++
+	results := r.group.DoChan(key, func() (interface{}, error) {
+		fetched.Store(true)
+		defer r.finish(key, call)
+		return fetch(call.ctx)
+	})
+	r.mu.Unlock()
+
+	select {
+	case res := <-results:
+		r.leave(key, call, false)
+		r.record(key, fetched.Load())
+		typed, _ := res.Val.(T)
+		return typed, res.Err
+	case <-ctx.Done():
+		r.leave(key, call, true)
+		r.record(key, fetched.Load())
+		return zero, ctx.Err()
+	}
+}
+
+// leave drops one waiter from call. When the last waiter gave up, the shared fetch is canceled
+// and forgotten so later callers start a fresh one instead of joining a canceled fetch.
+func (r *CoalescingWeatherRepository) leave(key string, call *inflightCall, gaveUp bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	call.waiters--
+	if !gaveUp || call.waiters > 0 {
+		return
+	}
+	if r.calls[key] == call {
+		delete(r.calls, key)
+		r.group.Forget(key)
+	}
+	call.cancel()
+}
+
+// finish releases call once its fetch has returned. The key is forgotten under the same lock that
+// guards joining, so a caller arriving afterwards starts a fresh fetch with its own inflightCall
+// instead of joining this one through singleflight while registering a call nobody releases.
+func (r *CoalescingWeatherRepository) finish(key string, call *inflightCall) {
+	r.mu.Lock()
+	if r.calls[key] == call {
+		delete(r.calls, key)
+		r.group.Forget(key)
+	}
+	r.mu.Unlock()
+
+	call.cancel()
+}
+
+// exactCoordKey identifies coordinates without rounding; only truly identical requests are merged.
+func exactCoordKey(lat float32, lon float32) string {
+	return fmt.Sprintf("%g,%g", lat, lon)
+}
+
+var _ repository.WeatherRepository = (*CoalescingWeatherRepository)(nil)
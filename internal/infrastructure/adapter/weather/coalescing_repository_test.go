@@ -0,0 +1,254 @@
+package weather
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"weather-api/internal/core/domain/entity"
+	"weather-api/pkg/language"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCoalescingWeatherRepository_SharesInFlightFetch(t *testing.T) {
+	// Arrange
+	release := make(chan struct{})
+	started := make(chan struct{}, 1)
+	stub := &stubWeatherRepository{
+		weatherFn: func(ctx context.Context, city string) (*entity.Weather, error) {
+			started <- struct{}{}
+			<-release
+			return &entity.Weather{City: city, Temperature: 12.5}, nil
+		},
+	}
+	repo := NewCoalescingWeatherRepository(stub)
+
+	const callers = 20
+	results := make([]*entity.Weather, callers)
+	var wg sync.WaitGroup
+
+	// Act
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		results[0], _ = repo.GetWeatherByCity(context.Background(), "London", language.English)
+	}()
+	<-started
+	for i := 1; i < callers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i], _ = repo.GetWeatherByCity(context.Background(), " london", language.English)
+		}(i)
+	}
+	// Give the followers time to join the in-flight call before releasing it.
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	// Assert
+	assert.Equal(t, int64(1), stub.calls.Load())
+	for _, result := range results {
+		assert.Same(t, results[0], result)
+	}
+
+	stats := repo.Stats()[cacheOpCurrent+"|london|en"]
+	assert.Equal(t, uint64(callers), stats.Callers)
+	assert.Equal(t, uint64(1), stats.Fetches)
+	assert.Equal(t, uint64(callers-1), stats.Coalesced)
+}
+
+func TestCoalescingWeatherRepository_SequentialCallsFetchAgain(t *testing.T) {
+	// Arrange
+	stub := &stubWeatherRepository{}
+	repo := NewCoalescingWeatherRepository(stub)
+
+	// Act
+	_, err1 := repo.GetAirQuality(context.Background(), 51.5, -0.12)
+	_, err2 := repo.GetAirQuality(context.Background(), 51.5, -0.12)
+
+	// Assert
+	assert.NoError(t, err1)
+	assert.NoError(t, err2)
+	assert.Equal(t, int64(2), stub.calls.Load())
+
+	stats := repo.Stats()[cacheOpAirQuality+"|51.5,-0.12"]
+	assert.Equal(t, uint64(2), stats.Fetches)
+	assert.Equal(t, uint64(0), stats.Coalesced)
+}
+
+func TestCoalescingWeatherRepository_DistinctKeysAreNotMerged(t *testing.T) {
+	// Arrange
+	stub := &stubWeatherRepository{}
+	repo := NewCoalescingWeatherRepository(stub)
+
+	// Act
+	_, _ = repo.GetOneCall(context.Background(), 10, 20, []entity.OneCallBlock{entity.OneCallHourly}, language.English)
+	_, _ = repo.GetOneCall(context.Background(), 10, 20, []entity.OneCallBlock{entity.OneCallDaily}, language.English)
+	_, _ = repo.GetForecastByCity(context.Background(), "Paris", language.English)
+
+	// Assert
+	assert.Equal(t, int64(3), stub.calls.Load())
+	assert.Len(t, repo.Stats(), 3)
+}
+
+func TestCoalescingWeatherRepository_PropagatesErrors(t *testing.T) {
+	// Arrange
+	upstreamErr := errors.New("upstream down")
+	stub := &stubWeatherRepository{
+		weatherFn: func(ctx context.Context, city string) (*entity.Weather, error) {
+			return nil, upstreamErr
+		},
+	}
+	repo := NewCoalescingWeatherRepository(stub)
+
+	// Act
+	result, err := repo.GetWeatherByCity(context.Background(), "Berlin", language.English)
+
+	// Assert
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, upstreamErr)
+}
+
+func TestCoalescingWeatherRepository_BoundsTrackedKeys(t *testing.T) {
+	// Arrange
+	repo := NewCoalescingWeatherRepository(&stubWeatherRepository{})
+
+	// Act
+	for i := 0; i < coalesceMaxTrackedKeys+5; i++ {
+		_, _ = repo.GetHistoricalWeather(context.Background(), 1, 2, time.Unix(int64(i), 0), language.English)
+	}
+
+	// Assert
+	stats := repo.Stats()
+	assert.Len(t, stats, coalesceMaxTrackedKeys+1)
+	assert.Equal(t, uint64(5), stats[coalesceOtherKey].Callers)
+}
+
+func TestCoalescingWeatherRepository_CallerLeavingDoesNotCancelSharedFetch(t *testing.T) {
+	// Arrange
+	release := make(chan struct{})
+	started := make(chan struct{}, 1)
+	stub := &stubWeatherRepository{
+		weatherFn: func(ctx context.Context, city string) (*entity.Weather, error) {
+			started <- struct{}{}
+			select {
+			case <-release:
+				return &entity.Weather{City: city}, nil
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+		},
+	}
+	repo := NewCoalescingWeatherRepository(stub)
+	leaderCtx, cancelLeader := context.WithCancel(context.Background())
+
+	var leaderErr error
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_, leaderErr = repo.GetWeatherByCity(leaderCtx, "Oslo", language.English)
+	}()
+	<-started
+
+	followerResult := make(chan *entity.Weather, 1)
+	go func() {
+		weather, _ := repo.GetWeatherByCity(context.Background(), "Oslo", language.English)
+		followerResult <- weather
+	}()
+	time.Sleep(50 * time.Millisecond)
+
+	// Act
+	cancelLeader()
+	<-done
+	close(release)
+
+	// Assert
+	assert.ErrorIs(t, leaderErr, context.Canceled)
+	weather := <-followerResult
+	assert.NotNil(t, weather)
+	assert.Equal(t, "Oslo", weather.City)
+	assert.Equal(t, int64(1), stub.calls.Load())
+}
+
+func TestCoalescingWeatherRepository_LastCallerLeavingCancelsFetch(t *testing.T) {
+	// Arrange
+	fetchErr := make(chan error, 1)
+	stub := &stubWeatherRepository{
+		weatherFn: func(ctx context.Context, city string) (*entity.Weather, error) {
+			<-ctx.Done()
+			fetchErr <- ctx.Err()
+			return nil, ctx.Err()
+		},
+	}
+	repo := NewCoalescingWeatherRepository(stub)
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	// Act
+	_, err := repo.GetWeatherByCity(ctx, "Oslo", language.English)
+
+	// Assert
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	select {
+	case upstreamErr := <-fetchErr:
+		assert.ErrorIs(t, upstreamErr, context.Canceled)
+	case <-time.After(time.Second):
+		t.Fatal("shared fetch was not canceled after the last caller left")
+	}
+}
+
+func TestCoalescingWeatherRepository_CallerAfterFinishStartsFreshFetch(t *testing.T) {
+	// Arrange
+	release := make(chan struct{})
+	started := make(chan struct{}, 1)
+	stub := &stubWeatherRepository{
+		weatherFn: func(ctx context.Context, city string) (*entity.Weather, error) {
+			select {
+			case started <- struct{}{}:
+				<-release
+			default:
+			}
+			return &entity.Weather{City: city}, nil
+		},
+	}
+	repo := NewCoalescingWeatherRepository(stub)
+	key := cacheOpCurrent + "|london|en"
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		_, _ = repo.GetWeatherByCity(context.Background(), "London", language.English)
+	}()
+	<-started
+
+	// Release the call the way its fetch does on return, while singleflight still holds the key.
+	repo.mu.Lock()
+	call := repo.calls[key]
+	repo.mu.Unlock()
+	repo.finish(key, call)
+
+	// Act
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_, _ = repo.GetWeatherByCity(context.Background(), "London", language.English)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+	}
+	close(release)
+	<-done
+	wg.Wait()
+
+	// Assert
+	assert.Equal(t, int64(2), stub.calls.Load())
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	assert.Empty(t, repo.calls)
+}
@@ -0,0 +1,239 @@
+package weather
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"weather-api/internal/core/domain/entity"
+	"weather-api/internal/core/domain/repository"
+	"weather-api/internal/infrastructure/config"
+	"weather-api/internal/infrastructure/support"
+	"weather-api/pkg/language"
+)
+
+// consensusProviderName is reported as the provider of blended readings.
+const consensusProviderName = "consensus"
+
+// ConsensusWeatherRepository asks every member for the current weather in parallel and blends the
+// numeric fields of the answers. Operations that are not blended are served by the members in
+// priority order, as with FailoverWeatherRepository.
+type ConsensusWeatherRepository struct {
+	*FailoverWeatherRepository
+
+	members  []FailoverMember
+	strategy string
+	timeout  time.Duration
+	weights  map[string]float64
+}
+
+// consensusAnswer is one member's reply to a blended request.
+type consensusAnswer struct {
+	index   int
+	weather *entity.Weather
+	err     error
+}
+
+// NewConsensusWeatherRepository builds a consensus repository over members, listed in priority order.
+func NewConsensusWeatherRepository(cfg config.ConsensusConfig, members ...FailoverMember) (*ConsensusWeatherRepository, error) {
+	switch cfg.Strategy {
+	case config.ConsensusMedian, config.ConsensusWeightedMean, config.ConsensusTrimmedMean:
+	default:
+		return nil, fmt.Errorf("unsupported consensus strategy %q (expected %q, %q or %q)",
+			cfg.Strategy, config.ConsensusMedian, config.ConsensusWeightedMean, config.ConsensusTrimmedMean)
+	}
+
+	return &ConsensusWeatherRepository{
+		FailoverWeatherRepository: NewFailoverWeatherRepository(members...),
+		members:                   members,
+		strategy:                  cfg.Strategy,
+		timeout:                   cfg.Timeout,
+		weights:                   cfg.Weights,
+	}, nil
+}
+
+// GetWeatherByCity blends the current weather reported by every member that answers before the shared deadline.
+// Members that fail or miss the deadline are listed in the consensus details. When no member answers,
+// the error of the highest priority member that replied is returned.
+func (r *ConsensusWeatherRepository) GetWeatherByCity(ctx context.Context, city string, lang language.Code) (*entity.Weather, error) {
+	return r.consensus(ctx, func(ctx context.Context, member repository.WeatherRepository) (*entity.Weather, error) {
+		return member.GetWeatherByCity(ctx, city, lang)
+	})
+}
+
+// GetWeatherByCoordinates blends the current weather at a coordinate like GetWeatherByCity.
+func (r *ConsensusWeatherRepository) GetWeatherByCoordinates(ctx context.Context, lat float32, lon float32, lang language.Code) (*entity.Weather, error) {
+	return r.consensus(ctx, func(ctx context.Context, member repository.WeatherRepository) (*entity.Weather, error) {
+		return member.GetWeatherByCoordinates(ctx, lat, lon, lang)
+	})
+}
+
+// consensus asks every member for the current weather with fetch and blends the answers.
+func (r *ConsensusWeatherRepository) consensus(ctx context.Context, fetch func(ctx context.Context, member repository.WeatherRepository) (*entity.Weather, error)) (*entity.Weather, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	deadlineCtx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	answers := make(chan consensusAnswer, len(r.members))
+	for i, member := range r.members {
+		go func() {
+			weather, err := fetch(deadlineCtx, member.Repository)
+			answers <- consensusAnswer{index: i, weather: weather, err: err}
+		}()
+	}
+
+	replies := make([]*consensusAnswer, len(r.members))
+collect:
+	for range r.members {
+		select {
+		case answer := <-answers:
+			replies[answer.index] = &answer
+		case <-deadlineCtx.Done():
+			break collect
+		}
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	var (
+		readings []*entity.Weather
+		weights  []float64
+		names    []string
+		failed   []string
+		firstErr error
+	)
+	for i, reply := range replies {
+		name := r.members[i].Name
+		if reply == nil || reply.err != nil {
+			failed = append(failed, name)
+			if reply != nil && firstErr == nil {
+				firstErr = reply.err
+			}
+			continue
+		}
+		readings = append(readings, reply.weather)
+		weights = append(weights, r.weight(name))
+		names = append(names, name)
+	}
+
+	if len(readings) == 0 {
+		if firstErr != nil {
+			return nil, firstErr
+		}
+		return nil, support.NewErrTimeout("timeout: no weather provider answered before the consensus deadline")
+	}
+
+	return r.blend(readings, weights, names, failed), nil
+}
+
+// blend combines readings into a single reading. Text fields come from the highest priority reading.
+func (r *ConsensusWeatherRepository) blend(readings []*entity.Weather, weights []float64, names []string, failed []string) *entity.Weather {
+	temperatures := make([]float64, len(readings))
+	humidities := make([]float64, len(readings))
+	windSpeeds := make([]float64, len(readings))
+	for i, reading := range readings {
+		temperatures[i] = reading.Temperature
+		humidities[i] = float64(reading.Humidity)
+		windSpeeds[i] = reading.WindSpeed
+	}
+
+	lead := readings[0]
+	return &entity.Weather{
+		City:        lead.City,
+		Temperature: r.combine(temperatures, weights),
+		Description: lead.Description,
+		Humidity:    int(math.Round(r.combine(humidities, weights))),
+		WindSpeed:   r.combine(windSpeeds, weights),
+		Timestamp:   lead.Timestamp,
+		Units:       lead.Units,
+		Language:    lead.Language,
+		Provider:    consensusProviderName,
+		Consensus: &entity.WeatherConsensus{
+			Strategy:          r.strategy,
+			Providers:         names,
+			FailedProviders:   failed,
+			TemperatureSpread: spread(temperatures),
+			HumiditySpread:    spread(humidities),
+			WindSpeedSpread:   spread(windSpeeds),
+		},
+	}
+}
+
+// combine reduces values with the configured strategy.
+func (r *ConsensusWeatherRepository) combine(values []float64, weights []float64) float64 {
+	switch r.strategy {
+	case config.ConsensusWeightedMean:
+		return weightedMean(values, weights)
+	case config.ConsensusTrimmedMean:
+		return trimmedMean(values)
+	default:
+		return median(values)
+	}
+}
+
+// weight returns the configured weight of a provider, defaulting to 1.
+func (r *ConsensusWeatherRepository) weight(name string) float64 {
+	if weight, ok := r.weights[name]; ok {
+		return weight
+	}
+	return 1
+}
+
+func sortedCopy(values []float64) []float64 {
+	sorted := append([]float64(nil), values...)
+	sort.Float64s(sorted)
+	return sorted
+}
+
+func median(values []float64) float64 {
+	sorted := sortedCopy(values)
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[mid-1] + sorted[mid]) / 2
+	}
+	return sorted[mid]
+}
+
+// weightedMean falls back to the plain mean when every weight is zero.
+func weightedMean(values []float64, weights []float64) float64 {
+	var sum, total float64
+	for i, value := range values {
+		sum += value * weights[i]
+		total += weights[i]
+	}
+	if total == 0 {
+		return mean(values)
+	}
+	return sum / total
+}
+
+// trimmedMean drops the lowest and highest value once at least three values are present.
+func trimmedMean(values []float64) float64 {
+	sorted := sortedCopy(values)
+	if len(sorted) >= 3 {
+		sorted = sorted[1 : len(sorted)-1]
+	}
+	return mean(sorted)
+}
+
+func mean(values []float64) float64 {
+	var sum float64
+	for _, value := range values {
+		sum += value
+	}
+	return sum / float64(len(values))
+}
+
+func spread(values []float64) float64 {
+	sorted := sortedCopy(values)
+	return sorted[len(sorted)-1] - sorted[0]
+}
+
+var _ repository.WeatherRepository = (*ConsensusWeatherRepository)(nil)
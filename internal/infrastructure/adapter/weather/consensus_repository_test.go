@@ -0,0 +1,184 @@
+package weather
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"weather-api/internal/core/domain/entity"
+	"weather-api/internal/infrastructure/config"
+	"weather-api/internal/infrastructure/support"
+	"weather-api/pkg/language"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func weatherMember(name string, temperature float64, humidity int, windSpeed float64) FailoverMember {
+	return FailoverMember{
+		Name: name,
+		Repository: &stubWeatherRepository{
+			weatherFn: func(ctx context.Context, city string) (*entity.Weather, error) {
+				return &entity.Weather{
+					City:        city,
+					Temperature: temperature,
+					Description: name + " sky",
+					Humidity:    humidity,
+					WindSpeed:   windSpeed,
+				}, nil
+			},
+		},
+	}
+}
+
+func failingMember(name string, err error) FailoverMember {
+	return FailoverMember{
+		Name: name,
+		Repository: &stubWeatherRepository{
+			weatherFn: func(ctx context.Context, city string) (*entity.Weather, error) {
+				return nil, err
+			},
+		},
+	}
+}
+
+func consensusConfig(strategy string) config.ConsensusConfig {
+	return config.ConsensusConfig{Strategy: strategy, Timeout: time.Second}
+}
+
+func TestConsensusWeatherRepository_Median(t *testing.T) {
+	// Arrange
+	repo, err := NewConsensusWeatherRepository(consensusConfig(config.ConsensusMedian),
+		weatherMember("a", 10, 50, 2),
+		weatherMember("b", 12, 60, 4),
+		weatherMember("c", 20, 70, 3),
+	)
+	assert.NoError(t, err)
+
+	// Act
+	weather, err := repo.GetWeatherByCity(context.Background(), "London", language.English)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 12.0, weather.Temperature)
+	assert.Equal(t, 60, weather.Humidity)
+	assert.Equal(t, 3.0, weather.WindSpeed)
+	assert.Equal(t, "a sky", weather.Description)
+	assert.Equal(t, "consensus", weather.Provider)
+	assert.Equal(t, []string{"a", "b", "c"}, weather.Consensus.Providers)
+	assert.Equal(t, 10.0, weather.Consensus.TemperatureSpread)
+	assert.Equal(t, 20.0, weather.Consensus.HumiditySpread)
+	assert.Equal(t, 2.0, weather.Consensus.WindSpeedSpread)
+}
+
+func TestConsensusWeatherRepository_WeightedMean(t *testing.T) {
+	// Arrange
+	cfg := consensusConfig(config.ConsensusWeightedMean)
+	cfg.Weights = map[string]float64{"a": 3}
+	repo, err := NewConsensusWeatherRepository(cfg,
+		weatherMember("a", 10, 40, 2),
+		weatherMember("b", 14, 80, 6),
+	)
+	assert.NoError(t, err)
+
+	// Act
+	weather, err := repo.GetWeatherByCity(context.Background(), "London", language.English)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 11.0, weather.Temperature)
+	assert.Equal(t, 50, weather.Humidity)
+	assert.Equal(t, 3.0, weather.WindSpeed)
+}
+
+func TestConsensusWeatherRepository_TrimmedMeanDropsExtremes(t *testing.T) {
+	// Arrange
+	repo, err := NewConsensusWeatherRepository(consensusConfig(config.ConsensusTrimmedMean),
+		weatherMember("a", -40, 50, 2),
+		weatherMember("b", 11, 60, 4),
+		weatherMember("c", 13, 60, 4),
+		weatherMember("d", 90, 70, 6),
+	)
+	assert.NoError(t, err)
+
+	// Act
+	weather, err := repo.GetWeatherByCity(context.Background(), "London", language.English)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 12.0, weather.Temperature)
+	assert.Equal(t, 130.0, weather.Consensus.TemperatureSpread)
+}
+
+func TestConsensusWeatherRepository_DegradesWhenProvidersFail(t *testing.T) {
+	// Arrange
+	slow := FailoverMember{
+		Name: "slow",
+		Repository: &stubWeatherRepository{
+			weatherFn: func(ctx context.Context, city string) (*entity.Weather, error) {
+				<-ctx.Done()
+				return nil, ctx.Err()
+			},
+		},
+	}
+	cfg := consensusConfig(config.ConsensusMedian)
+	cfg.Timeout = 50 * time.Millisecond
+	repo, err := NewConsensusWeatherRepository(cfg,
+		failingMember("broken", support.NewErrUpstream(503, "unavailable")),
+		weatherMember("healthy", 15, 55, 3),
+		slow,
+	)
+	assert.NoError(t, err)
+
+	// Act
+	weather, err := repo.GetWeatherByCity(context.Background(), "London", language.English)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 15.0, weather.Temperature)
+	assert.Equal(t, []string{"healthy"}, weather.Consensus.Providers)
+	assert.Equal(t, []string{"broken", "slow"}, weather.Consensus.FailedProviders)
+	assert.Equal(t, 0.0, weather.Consensus.TemperatureSpread)
+}
+
+func TestConsensusWeatherRepository_AllFailReturnsHighestPriorityError(t *testing.T) {
+	// Arrange
+	repo, err := NewConsensusWeatherRepository(consensusConfig(config.ConsensusMedian),
+		failingMember("a", support.NewErrNotFound("city not found")),
+		failingMember("b", support.NewErrUpstream(502, "bad gateway")),
+	)
+	assert.NoError(t, err)
+
+	// Act
+	_, err = repo.GetWeatherByCity(context.Background(), "Atlantis", language.English)
+
+	// Assert
+	assert.IsType(t, &support.ErrNotFound{}, err)
+}
+
+func TestConsensusWeatherRepository_OtherOperationsFailOver(t *testing.T) {
+	// Arrange
+	repo, err := NewConsensusWeatherRepository(consensusConfig(config.ConsensusMedian),
+		FailoverMember{Name: "a", Repository: &stubWeatherRepository{
+			forecastFn: func(ctx context.Context, city string) (*entity.Forecast, error) {
+				return nil, support.NewErrTimeout("timeout: a")
+			},
+		}},
+		FailoverMember{Name: "b", Repository: &stubWeatherRepository{}},
+	)
+	assert.NoError(t, err)
+
+	// Act
+	forecast, err := repo.GetForecastByCity(context.Background(), "London", language.English)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "London", forecast.City)
+}
+
+func TestNewConsensusWeatherRepository_UnknownStrategy(t *testing.T) {
+	// Act
+	_, err := NewConsensusWeatherRepository(consensusConfig("mode"))
+
+	// Assert
+	assert.Error(t, err)
+}
@@ -0,0 +1,180 @@
+package weather
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"weather-api/internal/core/domain/entity"
+	"weather-api/internal/core/domain/repository"
+	"weather-api/internal/infrastructure/support"
+	"weather-api/pkg/circuitbreaker"
+	"weather-api/pkg/language"
+	"weather-api/pkg/units"
+)
+
+// FailoverMember is one provider in a failover chain.
+type FailoverMember struct {
+	Name       string
+	Repository repository.WeatherRepository
+}
+
+// FailoverProviderStats counts how often a provider served a request and how often it was skipped
+// because it failed with an error worth retrying elsewhere.
+type FailoverProviderStats struct {
+	Served     uint64
+	FailedOver uint64
+}
+
+// FailoverWeatherRepository tries its members in priority order and moves on to the next one when
+// a provider times out, fails upstream, has used up its call quota, is short-circuited by its breaker or
+// does not support the call.
+// Errors that describe the request itself, such as not-found or bad-request, are returned as is.
+type FailoverWeatherRepository struct {
+	members []FailoverMember
+
+	mu    sync.Mutex
+	stats map[string]*FailoverProviderStats
+}
+
+// NewFailoverWeatherRepository builds a failover chain; members are tried in the given order.
+func NewFailoverWeatherRepository(members ...FailoverMember) *FailoverWeatherRepository {
+	stats := make(map[string]*FailoverProviderStats, len(members))
+	for _, member := range members {
+		stats[member.Name] = &FailoverProviderStats{}
+	}
+
+	return &FailoverWeatherRepository{
+		members: members,
+		stats:   stats,
+	}
+}
+
+func (r *FailoverWeatherRepository) GetWeatherByCity(ctx context.Context, city string, lang language.Code) (*entity.Weather, error) {
+	return failover(ctx, r, func(ctx context.Context, next repository.WeatherRepository) (*entity.Weather, error) {
+		return next.GetWeatherByCity(ctx, city, lang)
+	})
+}
+
+func (r *FailoverWeatherRepository) GetWeatherByCoordinates(ctx context.Context, lat float32, lon float32, lang language.Code) (*entity.Weather, error) {
+	return failover(ctx, r, func(ctx context.Context, next repository.WeatherRepository) (*entity.Weather, error) {
+		return next.GetWeatherByCoordinates(ctx, lat, lon, lang)
+	})
+}
+
+func (r *FailoverWeatherRepository) GetWeatherOverviewByLatLong(ctx context.Context, lon float32, lat float32, system units.System) (*entity.WeatherOverview, error) {
+	return failover(ctx, r, func(ctx context.Context, next repository.WeatherRepository) (*entity.WeatherOverview, error) {
+		return next.GetWeatherOverviewByLatLong(ctx, lon, lat, system)
+	})
+}
+
+func (r *FailoverWeatherRepository) GetForecastByCity(ctx context.Context, city string, lang language.Code) (*entity.Forecast, error) {
+	return failover(ctx, r, func(ctx context.Context, next repository.WeatherRepository) (*entity.Forecast, error) {
+		return next.GetForecastByCity(ctx, city, lang)
+	})
+}
+
+func (r *FailoverWeatherRepository) GetOneCall(ctx context.Context, lat float32, lon float32, include []entity.OneCallBlock, lang language.Code) (*entity.OneCall, error) {
+	return failover(ctx, r, func(ctx context.Context, next repository.WeatherRepository) (*entity.OneCall, error) {
+		return next.GetOneCall(ctx, lat, lon, include, lang)
+	})
+}
+
+func (r *FailoverWeatherRepository) GetAirQuality(ctx context.Context, lat float32, lon float32) (*entity.AirQuality, error) {
+	return failover(ctx, r, func(ctx context.Context, next repository.WeatherRepository) (*entity.AirQuality, error) {
+		return next.GetAirQuality(ctx, lat, lon)
+	})
+}
+
+func (r *FailoverWeatherRepository) GetAirQualityForecast(ctx context.Context, lat float32, lon float32) (*entity.AirQuality, error) {
+	return failover(ctx, r, func(ctx context.Context, next repository.WeatherRepository) (*entity.AirQuality, error) {
+		return next.GetAirQualityForecast(ctx, lat, lon)
+	})
+}
+
+func (r *FailoverWeatherRepository) GetAirQualityHistory(ctx context.Context, lat float32, lon float32, start time.Time, end time.Time) (*entity.AirQuality, error) {
+	return failover(ctx, r, func(ctx context.Context, next repository.WeatherRepository) (*entity.AirQuality, error) {
+		return next.GetAirQualityHistory(ctx, lat, lon, start, end)
+	})
+}
+
+func (r *FailoverWeatherRepository) GetHistoricalWeather(ctx context.Context, lat float32, lon float32, at time.Time, lang language.Code) (*entity.HistoricalWeather, error) {
+	return failover(ctx, r, func(ctx context.Context, next repository.WeatherRepository) (*entity.HistoricalWeather, error) {
+		return next.GetHistoricalWeather(ctx, lat, lon, at, lang)
+	})
+}
+
+func (r *FailoverWeatherRepository) GetDaySummary(ctx context.Context, lat float32, lon float32, date time.Time) (*entity.DaySummary, error) {
+	return failover(ctx, r, func(ctx context.Context, next repository.WeatherRepository) (*entity.DaySummary, error) {
+		return next.GetDaySummary(ctx, lat, lon, date)
+	})
+}
+
+// Stats returns a snapshot of the per-provider served and failed-over counters.
+func (r *FailoverWeatherRepository) Stats() map[string]FailoverProviderStats {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	snapshot := make(map[string]FailoverProviderStats, len(r.stats))
+	for name, stats := range r.stats {
+		snapshot[name] = *stats
+	}
+	return snapshot
+}
+
+func (r *FailoverWeatherRepository) record(name string, served bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if served {
+		r.stats[name].Served++
+	} else {
+		r.stats[name].FailedOver++
+	}
+}
+
+// failover calls fetch against each member in turn until one succeeds or fails with an error that
+// another provider would not fix. When every member fails over, the last provider's error is returned.
+func failover[T any](ctx context.Context, r *FailoverWeatherRepository, fetch func(ctx context.Context, next repository.WeatherRepository) (T, error)) (T, error) {
+	var zero T
+
+	for i, member := range r.members {
+		if err := ctx.Err(); err != nil {
+			return zero, err
+		}
+
+		result, err := fetch(ctx, member.Repository)
+		if err == nil {
+			r.record(member.Name, true)
+			return result, nil
+		}
+
+		if i == len(r.members)-1 || ctx.Err() != nil || !shouldFailover(err) {
+			return zero, err
+		}
+		r.record(member.Name, false)
+	}
+
+	return zero, fmt.Errorf("no weather providers configured")
+}
+
+// shouldFailover reports whether err, or any error it wraps, is a provider-side failure that another
+// provider may not share: a timeout, a 5xx, a rejected API key, upstream throttling, an exhausted quota,
+// an unsupported endpoint or an open breaker.
+func shouldFailover(err error) bool {
+	var (
+		timeout      *support.ErrTimeout
+		upstream     *support.ErrUpstream
+		unauthorized *support.ErrUpstreamUnauthorized
+		rateLimited  *support.ErrUpstreamRateLimited
+		quota        *support.ErrQuotaExceeded
+		unsupported  *support.ErrNotImplemented
+	)
+	return errors.As(err, &timeout) || errors.As(err, &upstream) || errors.As(err, &unauthorized) ||
+		errors.As(err, &rateLimited) || errors.As(err, &quota) || errors.As(err, &unsupported) ||
+		circuitbreaker.IsRejected(err)
+}
+
+var _ repository.WeatherRepository = (*FailoverWeatherRepository)(nil)
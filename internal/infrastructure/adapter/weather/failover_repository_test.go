@@ -0,0 +1,148 @@
+package weather
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"weather-api/internal/core/domain/entity"
+	"weather-api/internal/infrastructure/support"
+	"weather-api/pkg/circuitbreaker"
+	"weather-api/pkg/language"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFailoverWeatherRepository_FailsOverOnUpstreamError(t *testing.T) {
+	// Arrange
+	primary := &stubWeatherRepository{
+		weatherFn: func(ctx context.Context, city string) (*entity.Weather, error) {
+			return nil, support.NewErrUpstream(503, "service unavailable")
+		},
+	}
+	secondary := &stubWeatherRepository{
+		weatherFn: func(ctx context.Context, city string) (*entity.Weather, error) {
+			return &entity.Weather{City: city, Provider: "secondary"}, nil
+		},
+	}
+	repo := NewFailoverWeatherRepository(
+		FailoverMember{Name: "primary", Repository: primary},
+		FailoverMember{Name: "secondary", Repository: secondary},
+	)
+
+	// Act
+	weather, err := repo.GetWeatherByCity(context.Background(), "London", language.English)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "secondary", weather.Provider)
+	assert.Equal(t, int64(1), primary.calls.Load())
+	assert.Equal(t, int64(1), secondary.calls.Load())
+
+	stats := repo.Stats()
+	assert.Equal(t, uint64(1), stats["primary"].FailedOver)
+	assert.Equal(t, uint64(1), stats["secondary"].Served)
+}
+
+func TestFailoverWeatherRepository_FailsOverOnProviderErrors(t *testing.T) {
+	errs := []error{
+		support.NewErrTimeout("timeout: upstream did not respond in time"),
+		circuitbreaker.ErrOpenState,
+		support.NewErrNotImplemented("not supported"),
+		support.NewErrUpstreamUnauthorized("upstream rejected the API key (401 Unauthorized)"),
+		support.NewErrUpstreamRateLimited("upstream rate limit exceeded (429 Too Many Requests)", 0),
+		fmt.Errorf("fetch air quality: %w", support.NewErrUpstream(http.StatusBadGateway, "bad gateway")),
+	}
+
+	for _, primaryErr := range errs {
+		t.Run(primaryErr.Error(), func(t *testing.T) {
+			// Arrange
+			primary := &stubWeatherRepository{
+				airFn: func(ctx context.Context, lat float32, lon float32) (*entity.AirQuality, error) {
+					return nil, primaryErr
+				},
+			}
+			secondary := &stubWeatherRepository{}
+			repo := NewFailoverWeatherRepository(
+				FailoverMember{Name: "primary", Repository: primary},
+				FailoverMember{Name: "secondary", Repository: secondary},
+			)
+
+			// Act
+			_, err := repo.GetAirQuality(context.Background(), 51.5, -0.12)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, int64(1), secondary.calls.Load())
+		})
+	}
+}
+
+func TestFailoverWeatherRepository_DoesNotFailOverOnNotFound(t *testing.T) {
+	// Arrange
+	primary := &stubWeatherRepository{
+		weatherFn: func(ctx context.Context, city string) (*entity.Weather, error) {
+			return nil, support.NewErrNotFound("city not found")
+		},
+	}
+	secondary := &stubWeatherRepository{}
+	repo := NewFailoverWeatherRepository(
+		FailoverMember{Name: "primary", Repository: primary},
+		FailoverMember{Name: "secondary", Repository: secondary},
+	)
+
+	// Act
+	_, err := repo.GetWeatherByCity(context.Background(), "Atlantis", language.English)
+
+	// Assert
+	assert.IsType(t, &support.ErrNotFound{}, err)
+	assert.Equal(t, int64(0), secondary.calls.Load())
+}
+
+func TestFailoverWeatherRepository_ReturnsLastErrorWhenAllFail(t *testing.T) {
+	// Arrange
+	primary := &stubWeatherRepository{
+		weatherFn: func(ctx context.Context, city string) (*entity.Weather, error) {
+			return nil, support.NewErrTimeout("timeout: primary")
+		},
+	}
+	secondary := &stubWeatherRepository{
+		weatherFn: func(ctx context.Context, city string) (*entity.Weather, error) {
+			return nil, support.NewErrUpstream(502, "bad gateway")
+		},
+	}
+	repo := NewFailoverWeatherRepository(
+		FailoverMember{Name: "primary", Repository: primary},
+		FailoverMember{Name: "secondary", Repository: secondary},
+	)
+
+	// Act
+	_, err := repo.GetWeatherByCity(context.Background(), "London", language.English)
+
+	// Assert
+	assert.IsType(t, &support.ErrUpstream{}, err)
+}
+
+func TestFailoverWeatherRepository_StopsWhenContextCanceled(t *testing.T) {
+	// Arrange
+	ctx, cancel := context.WithCancel(context.Background())
+	primary := &stubWeatherRepository{
+		weatherFn: func(ctx context.Context, city string) (*entity.Weather, error) {
+			cancel()
+			return nil, support.NewErrTimeout("timeout: primary")
+		},
+	}
+	secondary := &stubWeatherRepository{}
+	repo := NewFailoverWeatherRepository(
+		FailoverMember{Name: "primary", Repository: primary},
+		FailoverMember{Name: "secondary", Repository: secondary},
+	)
+
+	// Act
+	_, err := repo.GetWeatherByCity(ctx, "London", language.English)
+
+	// Assert
+	assert.Error(t, err)
+	assert.Equal(t, int64(0), secondary.calls.Load())
+}
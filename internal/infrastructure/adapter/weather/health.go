@@ -0,0 +1,155 @@
+package weather
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"weather-api/internal/core/domain/entity"
+	"weather-api/internal/core/service"
+	"weather-api/pkg/circuitbreaker"
+)
+
+// prober is implemented by adapters that can check their upstream with one cheap request.
+type prober interface {
+	Probe(ctx context.Context) error
+}
+
+// breakerHolder is implemented by adapters that guard their upstream with circuit breakers.
+type breakerHolder interface {
+	CircuitBreakers() []*circuitbreaker.CircuitBreaker
+}
+
+// HealthChecks returns the readiness checks for the providers serving requests, built by NewProvider:
+// per provider an upstream probe whose result is reused for probeTTL, since every OpenWeather probe is
+// billed, and one check per circuit breaker. These are optional and only degrade the service, as a
+// failed probe or open breaker leaves the other providers and endpoints serving, and an exhausted
+// quota still leaves the stale cache. Only the circuit_breakers check, which fails once every breaker
+// of every provider is open, can make the service unready.
+func HealthChecks(probeTTL time.Duration, members ...FailoverMember) []service.HealthCheck {
+	var checks []service.HealthCheck
+	var breakers []*circuitbreaker.CircuitBreaker
+	for _, member := range members {
+		if p, ok := member.Repository.(prober); ok {
+			checks = append(checks, service.HealthCheck{Name: "upstream:" + member.Name, Check: p.Probe, CacheTTL: probeTTL, Optional: true})
+		}
+		if holder, ok := member.Repository.(breakerHolder); ok {
+			for _, breaker := range holder.CircuitBreakers() {
+				checks = append(checks, service.HealthCheck{Name: "circuit_breaker:" + breaker.Name(), Check: breakerCheck(breaker), Optional: true})
+				breakers = append(breakers, breaker)
+			}
+		}
+	}
+	if len(breakers) > 0 {
+		checks = append(checks, service.HealthCheck{Name: "circuit_breakers", Check: allBreakersCheck(breakers)})
+	}
+	return checks
+}
+
+// CircuitBreakerStatuses reports the circuit breakers of a set of providers built by NewProvider.
+type CircuitBreakerStatuses struct {
+	breakers []*circuitbreaker.CircuitBreaker
+}
+
+// NewCircuitBreakerStatuses collects the breakers of providers, in order.
+func NewCircuitBreakerStatuses(providers ...Provider) *CircuitBreakerStatuses {
+	statuses := &CircuitBreakerStatuses{}
+	for _, provider := range providers {
+		if holder, ok := provider.(breakerHolder); ok {
+			statuses.breakers = append(statuses.breakers, holder.CircuitBreakers()...)
+		}
+	}
+	return statuses
+}
+
+// Statuses returns a snapshot of every collected breaker.
+func (s *CircuitBreakerStatuses) Statuses() []entity.CircuitBreakerStatus {
+	statuses := make([]entity.CircuitBreakerStatus, len(s.breakers))
+	for i, breaker := range s.breakers {
+		snapshot := breaker.Snapshot()
+		statuses[i] = entity.CircuitBreakerStatus{
+			Name:                snapshot.Name,
+			State:               snapshot.State.String(),
+			Requests:            snapshot.Requests,
+			Successes:           snapshot.TotalSuccesses,
+			Failures:            snapshot.TotalFailures,
+			ConsecutiveFailures: snapshot.ConsecutiveFailures,
+			Rejected:            snapshot.Rejected,
+		}
+	}
+	return statuses
+}
+
+// breakerCheck fails while breaker is open. A half-open breaker is letting trial calls through and counts as up.
+func breakerCheck(breaker *circuitbreaker.CircuitBreaker) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		if breaker.State() == circuitbreaker.StateOpen {
+			return fmt.Errorf("circuit breaker %s is open", breaker.Name())
+		}
+		return nil
+	}
+}
+
+// allBreakersCheck fails while every one of breakers is open, when no provider can serve any request.
+func allBreakersCheck(breakers []*circuitbreaker.CircuitBreaker) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		for _, breaker := range breakers {
+			if breaker.State() != circuitbreaker.StateOpen {
+				return nil
+			}
+		}
+		return errors.New("every circuit breaker is open")
+	}
+}
+
+// Probe looks up one place through the geocoding API, the cheapest OpenWeather call that still
+// proves the API key is accepted. It bypasses the circuit breaker and retries but is counted against the quota.
+func (a *OpenWeatherAdapter) Probe(ctx context.Context) error {
+	endpoint := fmt.Sprintf("%s/geo/1.0/direct?q=London&limit=1&appid=%s", a.baseURL, a.apiKey)
+	return probe(ctx, a.client, endpoint, func() error {
+		return a.quota.Reserve(QuotaEndpointWeather)
+	})
+}
+
+// CircuitBreakers returns the breakers guarding the OpenWeather endpoints.
+func (a *OpenWeatherAdapter) CircuitBreakers() []*circuitbreaker.CircuitBreaker {
+	breakers := make([]*circuitbreaker.CircuitBreaker, len(openWeatherAPIs))
+	for i, api := range openWeatherAPIs {
+		breakers[i] = a.breakers[api]
+	}
+	return breakers
+}
+
+// Probe requests a single current variable for one coordinate from the forecast API.
+// It bypasses the circuit breaker and retries.
+func (a *OpenMeteoAdapter) Probe(ctx context.Context) error {
+	endpoint := fmt.Sprintf("%s/v1/forecast?latitude=0&longitude=0&current=temperature_2m", a.forecastURL)
+	return probe(ctx, a.client, endpoint, nil)
+}
+
+// CircuitBreakers returns the breakers guarding the Open-Meteo APIs.
+func (a *OpenMeteoAdapter) CircuitBreakers() []*circuitbreaker.CircuitBreaker {
+	return []*circuitbreaker.CircuitBreaker{a.circuitBreaker}
+}
+
+// probe makes a single GET and fails unless the upstream answers 200.
+func probe(ctx context.Context, client *http.Client, endpoint string, reserve func() error) error {
+	resp, err := getWithRetry(ctx, client, endpoint, 1, 0, 0, reserve)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	switch {
+	case resp.StatusCode == http.StatusOK:
+		return nil
+	case resp.StatusCode == http.StatusUnauthorized:
+		return fmt.Errorf("upstream rejected the API key (%s)", resp.Status)
+	default:
+		return fmt.Errorf("upstream answered %s", resp.Status)
+	}
+}
@@ -0,0 +1,183 @@
+package weather
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"weather-api/internal/core/domain/entity"
+	"weather-api/internal/infrastructure/config"
+	"weather-api/internal/infrastructure/support"
+	"weather-api/pkg/circuitbreaker"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOpenWeatherAdapter_Probe(t *testing.T) {
+	// Arrange
+	var paths []string
+	status := http.StatusOK
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		w.WriteHeader(status)
+	}))
+	defer mockServer.Close()
+
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	adapter := &OpenWeatherAdapter{
+		client:      &http.Client{Timeout: 10 * time.Second},
+		apiKey:      "test-api-key",
+		baseURL:     mockServer.URL,
+		breakers:    newOpenWeatherBreakers(circuitbreaker.DefaultSettings(), nil),
+		maxAttempts: 3,
+		quota:       newTestQuotaManager(config.QuotaConfig{Weather: config.QuotaBudget{PerMinute: 2}}, &now),
+	}
+
+	// Act
+	up := adapter.Probe(context.Background())
+	status = http.StatusUnauthorized
+	rejected := adapter.Probe(context.Background())
+	exhausted := adapter.Probe(context.Background())
+
+	// Assert
+	assert.NoError(t, up)
+	assert.EqualError(t, rejected, "upstream rejected the API key (401 Unauthorized)")
+	assert.IsType(t, &support.ErrQuotaExceeded{}, exhausted, "probes count against the quota")
+	assert.Equal(t, []string{"/geo/1.0/direct", "/geo/1.0/direct"}, paths, "probes are not retried")
+}
+
+func TestHealthChecks(t *testing.T) {
+	// Arrange
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer mockServer.Close()
+
+	breaker := circuitbreaker.NewCircuitBreaker("test-open-meteo")
+	adapter := &OpenMeteoAdapter{
+		client:         &http.Client{Timeout: 10 * time.Second},
+		forecastURL:    mockServer.URL,
+		circuitBreaker: breaker,
+	}
+
+	// Act
+	checks := HealthChecks(time.Minute, FailoverMember{Name: "openmeteo", Repository: adapter})
+	closed := checks[1].Check(context.Background())
+	allClosed := checks[2].Check(context.Background())
+	for i := 0; i < 3; i++ {
+		_, _ = circuitbreaker.Execute(context.Background(), breaker, func(ctx context.Context) (interface{}, error) { return nil, errors.New("boom") })
+	}
+	open := checks[1].Check(context.Background())
+	allOpen := checks[2].Check(context.Background())
+
+	// Assert
+	if !assert.Len(t, checks, 3) {
+		return
+	}
+	assert.Equal(t, "upstream:openmeteo", checks[0].Name)
+	assert.Equal(t, time.Minute, checks[0].CacheTTL)
+	assert.True(t, checks[0].Optional)
+	assert.NoError(t, checks[0].Check(context.Background()))
+	assert.Equal(t, "circuit_breaker:test-open-meteo", checks[1].Name)
+	assert.Zero(t, checks[1].CacheTTL)
+	assert.True(t, checks[1].Optional)
+	assert.NoError(t, closed)
+	assert.EqualError(t, open, "circuit breaker test-open-meteo is open")
+	assert.Equal(t, "circuit_breakers", checks[2].Name)
+	assert.False(t, checks[2].Optional)
+	assert.NoError(t, allClosed)
+	assert.EqualError(t, allOpen, "every circuit breaker is open")
+}
+
+func TestHealthChecks_OneOpenBreakerKeepsProviderReady(t *testing.T) {
+	// Arrange
+	adapter := &OpenWeatherAdapter{breakers: newOpenWeatherBreakers(circuitbreaker.DefaultSettings(), nil)}
+	for i := 0; i < 3; i++ {
+		_, _ = circuitbreaker.Execute(context.Background(), adapter.breakers[openWeatherAPIOneCall], func(ctx context.Context) (interface{}, error) { return nil, errors.New("boom") })
+	}
+
+	// Act
+	checks := HealthChecks(time.Minute, FailoverMember{Name: "openweather", Repository: adapter})
+
+	// Assert
+	var degraded []string
+	for _, check := range checks[1:] { // skip the upstream probe
+		if err := check.Check(context.Background()); err != nil {
+			assert.True(t, check.Optional, check.Name)
+			degraded = append(degraded, check.Name)
+		}
+	}
+	assert.Equal(t, []string{"circuit_breaker:openweather-onecall"}, degraded)
+}
+
+func TestHealthChecks_FailoverKeepsServiceReady(t *testing.T) {
+	// Arrange
+	primaryServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer primaryServer.Close()
+	fallbackServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer fallbackServer.Close()
+
+	primary := &OpenWeatherAdapter{
+		client:   &http.Client{Timeout: 10 * time.Second},
+		baseURL:  primaryServer.URL,
+		breakers: newOpenWeatherBreakers(circuitbreaker.DefaultSettings(), nil),
+	}
+	for _, breaker := range primary.CircuitBreakers() {
+		for i := 0; i < 3; i++ {
+			_, _ = circuitbreaker.Execute(context.Background(), breaker, func(ctx context.Context) (interface{}, error) { return nil, errors.New("boom") })
+		}
+	}
+	fallback := &OpenMeteoAdapter{
+		client:         &http.Client{Timeout: 10 * time.Second},
+		forecastURL:    fallbackServer.URL,
+		circuitBreaker: circuitbreaker.NewCircuitBreaker("test-open-meteo"),
+	}
+
+	// Act
+	checks := HealthChecks(time.Minute,
+		FailoverMember{Name: "openweather", Repository: primary},
+		FailoverMember{Name: "openmeteo", Repository: fallback},
+	)
+
+	// Assert
+	names := make(map[string]bool, len(checks))
+	for _, check := range checks {
+		names[check.Name] = true
+		if err := check.Check(context.Background()); err != nil {
+			assert.True(t, check.Optional, "%s failed with %v", check.Name, err)
+		}
+	}
+	assert.True(t, names["upstream:openweather"])
+	assert.True(t, names["upstream:openmeteo"])
+	assert.True(t, names["circuit_breaker:test-open-meteo"])
+	assert.True(t, names["circuit_breakers"])
+}
+
+func TestCircuitBreakerStatuses(t *testing.T) {
+	// Arrange
+	openMeteo := &OpenMeteoAdapter{circuitBreaker: circuitbreaker.NewCircuitBreaker("test-open-meteo")}
+	openWeather := &OpenWeatherAdapter{breakers: newOpenWeatherBreakers(circuitbreaker.DefaultSettings(), nil)}
+	for i := 0; i < 4; i++ {
+		_, _ = circuitbreaker.Execute(context.Background(), openMeteo.circuitBreaker, func(ctx context.Context) (interface{}, error) { return nil, errors.New("boom") })
+	}
+
+	// Act
+	statuses := NewCircuitBreakerStatuses(openMeteo, openWeather).Statuses()
+
+	// Assert
+	if !assert.Len(t, statuses, 9) {
+		return
+	}
+	// Opening the breaker starts a new window, so only the fourth, rejected call shows.
+	assert.Equal(t, entity.CircuitBreakerStatus{Name: "test-open-meteo", State: "open", Rejected: 1}, statuses[0])
+	assert.Equal(t, "openweather-weather", statuses[1].Name)
+	assert.Equal(t, "closed", statuses[1].State)
+	assert.Equal(t, "openweather-geocoding", statuses[8].Name)
+}
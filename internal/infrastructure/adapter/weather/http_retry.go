@@ -0,0 +1,177 @@
+package weather
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"weather-api/internal/infrastructure/support"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const instrumentationName = "weather-api/internal/infrastructure/adapter/weather"
+
+// getWithRetry issues a GET bound to ctx and retries 5xx responses with exponential backoff.
+// The last 5xx response is returned once maxAttempts is reached; backoff waits end early when ctx is done.
+// Transport failures are reported as support.ErrTimeout or support.ErrUpstream.
+//
+// When reserve is set it is called before every attempt. If it refuses the first attempt its error is
+// returned; if it refuses a retry, the last 5xx response is returned instead.
+func getWithRetry(ctx context.Context, client *http.Client, rawURL string, maxAttempts int, initialBackoff time.Duration, maxBackoff time.Duration, reserve func() error) (*http.Response, error) {
+	if reserve != nil {
+		if err := reserve(); err != nil {
+			return nil, err
+		}
+	}
+
+	var attempt int
+	var waited time.Duration
+	backoff := initialBackoff
+	for {
+		attempt++
+		resp, err := doAttempt(ctx, client, rawURL, attempt, waited)
+		if err != nil {
+			return nil, err
+		}
+		if resp.StatusCode < 500 {
+			return resp, nil
+		}
+		if attempt >= maxAttempts {
+			return resp, nil
+		}
+		if reserve != nil && reserve() != nil {
+			return resp, nil
+		}
+		_ = resp.Body.Close()
+		if backoff > maxBackoff {
+			backoff = maxBackoff
+		}
+		if err := sleepContext(ctx, backoff); err != nil {
+			return nil, err
+		}
+		waited = backoff
+		backoff *= 2
+	}
+}
+
+// doAttempt performs one GET inside a client span carrying the attempt number, the backoff waited
+// before it and the response status, and records it in the upstream metrics. The W3C trace context
+// is propagated to the upstream. Only the host and path are recorded; the query carries the API key.
+func doAttempt(ctx context.Context, client *http.Client, rawURL string, attempt int, waited time.Duration) (*http.Response, error) {
+	host, endpoint := urlEndpoint(rawURL)
+	ctx, span := otel.Tracer(instrumentationName).Start(ctx, "GET "+endpoint,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("http.request.method", http.MethodGet),
+			attribute.String("server.address", host),
+			attribute.String("url.path", endpoint),
+			attribute.Int("retry.attempt", attempt),
+			attribute.Int64("retry.backoff_ms", waited.Milliseconds()),
+		))
+	defer span.End()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		span.SetStatus(codes.Error, "invalid request")
+		return nil, err
+	}
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(req.Header))
+
+	start := time.Now()
+	resp, err := client.Do(req)
+	if err != nil {
+		err = transportError(ctx, err)
+		observeAttempt(host, endpoint, attempt, nil, err, time.Since(start))
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	observeAttempt(host, endpoint, attempt, resp, nil, time.Since(start))
+	span.SetAttributes(attribute.Int("http.response.status_code", resp.StatusCode))
+	if resp.StatusCode >= http.StatusInternalServerError {
+		span.SetStatus(codes.Error, resp.Status)
+	}
+	return resp, nil
+}
+
+// urlEndpoint returns the host and path of rawURL, leaving out the query.
+func urlEndpoint(rawURL string) (string, string) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", ""
+	}
+	return u.Host, u.Path
+}
+
+// transportError classifies a failed round trip. A canceled caller is reported as ctx.Err(),
+// timeouts as support.ErrTimeout and anything else as a 502 support.ErrUpstream. The request URL
+// is stripped from the message because it carries the API key.
+func transportError(ctx context.Context, err error) error {
+	if errors.Is(err, context.Canceled) && ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	cause := err
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		cause = urlErr.Err
+	}
+
+	var ne net.Error
+	if errors.Is(err, context.DeadlineExceeded) || (errors.As(err, &ne) && ne.Timeout()) {
+		return support.NewErrTimeout(fmt.Sprintf("timeout: upstream did not respond in time: %v", cause))
+	}
+	return support.NewErrUpstream(http.StatusBadGateway, cause.Error())
+}
+
+// statusError maps an upstream answer other than 200 and 404 to a typed error. A 400 is the caller's
+// fault and is reported as support.ErrBadRequest with reason, the upstream's own explanation if it gave
+// one. Rejected credentials, throttling and 5xx answers are reported as upstream failures.
+func statusError(resp *http.Response, body []byte, reason string) error {
+	switch {
+	case resp.StatusCode == http.StatusBadRequest:
+		if reason == "" {
+			reason = "upstream rejected the request as invalid"
+		}
+		return support.NewErrBadRequest(reason)
+	case resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden:
+		return support.NewErrUpstreamUnauthorized(fmt.Sprintf("upstream rejected the API key (%s)", resp.Status))
+	case resp.StatusCode == http.StatusTooManyRequests:
+		return support.NewErrUpstreamRateLimited(fmt.Sprintf("upstream rate limit exceeded (%s)", resp.Status), parseRetryAfter(resp.Header.Get("Retry-After")))
+	case resp.StatusCode >= http.StatusInternalServerError:
+		return support.NewErrUpstream(resp.StatusCode, string(body))
+	}
+	return fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))
+}
+
+// parseRetryAfter reads a Retry-After header given in seconds; dates and malformed values give zero.
+func parseRetryAfter(value string) time.Duration {
+	seconds, err := strconv.Atoi(value)
+	if err != nil || seconds < 0 {
+		return 0
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+// sleepContext waits for d or until ctx is done, whichever comes first.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
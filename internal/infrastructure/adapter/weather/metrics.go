@@ -0,0 +1,136 @@
+package weather
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"weather-api/internal/infrastructure/support"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// Upstream call metrics, one observation per attempt. endpoint is the URL path, which never carries
+// the API key or the looked up location, so its cardinality is bounded by the adapters' routes.
+var (
+	upstreamRequestsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "weather_api",
+		Name:      "upstream_requests_total",
+		Help:      "Upstream HTTP attempts by host, endpoint, attempt number and outcome.",
+	}, []string{"host", "endpoint", "attempt", "outcome"})
+
+	upstreamRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "weather_api",
+		Name:      "upstream_request_duration_seconds",
+		Help:      "Upstream HTTP attempt latency by host, endpoint, attempt number and outcome.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"host", "endpoint", "attempt", "outcome"})
+)
+
+// observeAttempt records one upstream attempt; outcome is derived from the response or the
+// classified transport error.
+func observeAttempt(host string, endpoint string, attempt int, resp *http.Response, err error, elapsed time.Duration) {
+	labels := prometheus.Labels{
+		"host":     host,
+		"endpoint": endpoint,
+		"attempt":  strconv.Itoa(attempt),
+		"outcome":  attemptOutcome(resp, err),
+	}
+	upstreamRequestsTotal.With(labels).Inc()
+	upstreamRequestDuration.With(labels).Observe(elapsed.Seconds())
+}
+
+// attemptOutcome classifies an attempt as success, client_error, server_error, timeout, canceled or error.
+func attemptOutcome(resp *http.Response, err error) string {
+	switch err.(type) {
+	case nil:
+	case *support.ErrTimeout:
+		return "timeout"
+	case *support.ErrUpstream:
+		return "error"
+	default:
+		return "canceled"
+	}
+
+	switch {
+	case resp.StatusCode >= http.StatusInternalServerError:
+		return "server_error"
+	case resp.StatusCode >= http.StatusBadRequest:
+		return "client_error"
+	default:
+		return "success"
+	}
+}
+
+var (
+	cacheLookupsDesc = prometheus.NewDesc("weather_api_cache_lookups_total",
+		"Response cache lookups by operation and result (hit or miss); stale counts the misses answered with an expired entry.", []string{"operation", "result"}, nil)
+	cacheHitRatioDesc = prometheus.NewDesc("weather_api_cache_hit_ratio",
+		"Share of response cache lookups answered from the cache since start.", nil, nil)
+	cacheEntriesDesc = prometheus.NewDesc("weather_api_cache_entries",
+		"Entries held by the response cache, including expired ones not yet evicted.", nil, nil)
+)
+
+// Describe implements prometheus.Collector.
+func (r *CachedWeatherRepository) Describe(ch chan<- *prometheus.Desc) {
+	ch <- cacheLookupsDesc
+	ch <- cacheHitRatioDesc
+	ch <- cacheEntriesDesc
+}
+
+// Collect implements prometheus.Collector, reporting a snapshot of Stats.
+func (r *CachedWeatherRepository) Collect(ch chan<- prometheus.Metric) {
+	stats := r.Stats()
+	for op, opStats := range stats.Operations {
+		ch <- prometheus.MustNewConstMetric(cacheLookupsDesc, prometheus.CounterValue, float64(opStats.Hits), op, "hit")
+		ch <- prometheus.MustNewConstMetric(cacheLookupsDesc, prometheus.CounterValue, float64(opStats.Misses), op, "miss")
+		ch <- prometheus.MustNewConstMetric(cacheLookupsDesc, prometheus.CounterValue, float64(opStats.Stale), op, "stale")
+	}
+	ch <- prometheus.MustNewConstMetric(cacheHitRatioDesc, prometheus.GaugeValue, stats.HitRatio())
+	ch <- prometheus.MustNewConstMetric(cacheEntriesDesc, prometheus.GaugeValue, float64(stats.Entries))
+}
+
+var _ prometheus.Collector = (*CachedWeatherRepository)(nil)
+
+var failoverRequestsDesc = prometheus.NewDesc("weather_api_failover_requests_total",
+	"Requests handled by each failover provider by result: served, or failed_over to the next provider.", []string{"provider", "result"}, nil)
+
+// Describe implements prometheus.Collector.
+func (r *FailoverWeatherRepository) Describe(ch chan<- *prometheus.Desc) {
+	ch <- failoverRequestsDesc
+}
+
+// Collect implements prometheus.Collector, reporting a snapshot of Stats.
+func (r *FailoverWeatherRepository) Collect(ch chan<- prometheus.Metric) {
+	for name, stats := range r.Stats() {
+		ch <- prometheus.MustNewConstMetric(failoverRequestsDesc, prometheus.CounterValue, float64(stats.Served), name, "served")
+		ch <- prometheus.MustNewConstMetric(failoverRequestsDesc, prometheus.CounterValue, float64(stats.FailedOver), name, "failed_over")
+	}
+}
+
+var _ prometheus.Collector = (*FailoverWeatherRepository)(nil)
+
+var (
+	coalescedCallersDesc = prometheus.NewDesc("weather_coalesced_callers_total",
+		"Callers of the request coalescer by key; those beyond the fetches were served by another caller's fetch.", []string{"key"}, nil)
+	coalescedFetchesDesc = prometheus.NewDesc("weather_coalesced_fetches_total",
+		"Upstream fetches run by the request coalescer by key.", []string{"key"}, nil)
+)
+
+// Describe implements prometheus.Collector.
+func (r *CoalescingWeatherRepository) Describe(ch chan<- *prometheus.Desc) {
+	ch <- coalescedCallersDesc
+	ch <- coalescedFetchesDesc
+}
+
+// Collect implements prometheus.Collector, reporting a snapshot of Stats. Keys are bounded by
+// coalesceMaxTrackedKeys, the rest are reported as coalesceOtherKey.
+func (r *CoalescingWeatherRepository) Collect(ch chan<- prometheus.Metric) {
+	for key, stats := range r.Stats() {
+		ch <- prometheus.MustNewConstMetric(coalescedCallersDesc, prometheus.CounterValue, float64(stats.Callers), key)
+		ch <- prometheus.MustNewConstMetric(coalescedFetchesDesc, prometheus.CounterValue, float64(stats.Fetches), key)
+	}
+}
+
+var _ prometheus.Collector = (*CoalescingWeatherRepository)(nil)
@@ -0,0 +1,133 @@
+package weather
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"weather-api/internal/core/domain/entity"
+	"weather-api/internal/infrastructure/support"
+	"weather-api/pkg/language"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetWithRetry_RecordsEveryAttempt(t *testing.T) {
+	// Arrange
+	var calls atomic.Int64
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer mockServer.Close()
+
+	host := strings.TrimPrefix(mockServer.URL, "http://")
+	failed := upstreamRequestsTotal.WithLabelValues(host, "/data/2.5/weather", "1", "server_error")
+	retried := upstreamRequestsTotal.WithLabelValues(host, "/data/2.5/weather", "2", "success")
+
+	// Act
+	resp, err := getWithRetry(context.Background(), mockServer.Client(), mockServer.URL+"/data/2.5/weather?q=London&appid=secret", 2, time.Millisecond, time.Millisecond, nil)
+
+	// Assert
+	assert.NoError(t, err)
+	_ = resp.Body.Close()
+	assert.Equal(t, 1.0, testutil.ToFloat64(failed))
+	assert.Equal(t, 1.0, testutil.ToFloat64(retried))
+}
+
+func TestGetWithRetry_RecordsTimeouts(t *testing.T) {
+	// Arrange
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(50 * time.Millisecond)
+	}))
+	defer mockServer.Close()
+	client := &http.Client{Timeout: 10 * time.Millisecond}
+	u, _ := url.Parse(mockServer.URL)
+
+	// Act
+	_, err := getWithRetry(context.Background(), client, mockServer.URL+"/slow", 1, 0, 0, nil)
+
+	// Assert
+	assert.Error(t, err)
+	assert.Equal(t, "timeout", attemptOutcome(nil, err))
+	assert.Equal(t, 1.0, testutil.ToFloat64(upstreamRequestsTotal.WithLabelValues(u.Host, "/slow", "1", "timeout")))
+	assert.Equal(t, "canceled", attemptOutcome(nil, context.Canceled))
+}
+
+func TestCachedWeatherRepository_Collect(t *testing.T) {
+	// Arrange
+	repo := NewCachedWeatherRepository(&stubWeatherRepository{}, testCacheConfig())
+	_, _ = repo.GetWeatherByCity(context.Background(), "London", language.English)
+	_, _ = repo.GetWeatherByCity(context.Background(), "London", language.English)
+
+	// Act
+	expected := `
+# HELP weather_api_cache_hit_ratio Share of response cache lookups answered from the cache since start.
+# TYPE weather_api_cache_hit_ratio gauge
+weather_api_cache_hit_ratio 0.5
+# HELP weather_api_cache_entries Entries held by the response cache, including expired ones not yet evicted.
+# TYPE weather_api_cache_entries gauge
+weather_api_cache_entries 1
+`
+	err := testutil.CollectAndCompare(repo, strings.NewReader(expected), "weather_api_cache_hit_ratio", "weather_api_cache_entries")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 5, testutil.CollectAndCount(repo), "three lookup results for one operation plus ratio and entries")
+}
+
+func TestCoalescingWeatherRepository_Collect(t *testing.T) {
+	// Arrange
+	repo := NewCoalescingWeatherRepository(&stubWeatherRepository{})
+	_, _ = repo.GetWeatherByCity(context.Background(), "London", language.English)
+	_, _ = repo.GetWeatherByCity(context.Background(), "London", language.English)
+
+	// Act
+	expected := `
+# HELP weather_coalesced_callers_total Callers of the request coalescer by key; those beyond the fetches were served by another caller's fetch.
+# TYPE weather_coalesced_callers_total counter
+weather_coalesced_callers_total{key="current|london|en"} 2
+# HELP weather_coalesced_fetches_total Upstream fetches run by the request coalescer by key.
+# TYPE weather_coalesced_fetches_total counter
+weather_coalesced_fetches_total{key="current|london|en"} 2
+`
+	err := testutil.CollectAndCompare(repo, strings.NewReader(expected))
+
+	// Assert
+	assert.NoError(t, err)
+}
+
+func TestFailoverWeatherRepository_Collect(t *testing.T) {
+	// Arrange
+	primary := &stubWeatherRepository{weatherFn: func(ctx context.Context, city string) (*entity.Weather, error) {
+		return nil, support.NewErrTimeout("timeout")
+	}}
+	repo := NewFailoverWeatherRepository(
+		FailoverMember{Name: "openweather", Repository: primary},
+		FailoverMember{Name: "openmeteo", Repository: &stubWeatherRepository{}},
+	)
+	_, _ = repo.GetWeatherByCity(context.Background(), "London", language.English)
+
+	// Act
+	expected := `
+# HELP weather_api_failover_requests_total Requests handled by each failover provider by result: served, or failed_over to the next provider.
+# TYPE weather_api_failover_requests_total counter
+weather_api_failover_requests_total{provider="openmeteo",result="failed_over"} 0
+weather_api_failover_requests_total{provider="openmeteo",result="served"} 1
+weather_api_failover_requests_total{provider="openweather",result="failed_over"} 1
+weather_api_failover_requests_total{provider="openweather",result="served"} 0
+`
+	err := testutil.CollectAndCompare(repo, strings.NewReader(expected))
+
+	// Assert
+	assert.NoError(t, err)
+}
@@ -0,0 +1,436 @@
+package weather
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"weather-api/internal/core/domain/entity"
+	"weather-api/internal/core/domain/repository"
+	"weather-api/internal/infrastructure/config"
+	"weather-api/internal/infrastructure/support"
+	"weather-api/pkg/circuitbreaker"
+	"weather-api/pkg/language"
+	"weather-api/pkg/units"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// openMeteoCurrentVariables are requested for current conditions.
+	openMeteoCurrentVariables = "temperature_2m,apparent_temperature,relative_humidity_2m,dew_point_2m,pressure_msl," +
+		"cloud_cover,visibility,wind_speed_10m,wind_direction_10m,uv_index,weather_code"
+	// openMeteoHourlyVariables are requested for hourly forecasts.
+	openMeteoHourlyVariables = "temperature_2m,apparent_temperature,relative_humidity_2m,wind_speed_10m," +
+		"precipitation_probability,weather_code"
+	// openMeteoDailyVariables are requested for daily forecasts.
+	openMeteoDailyVariables = "weather_code,temperature_2m_max,temperature_2m_min,relative_humidity_2m_mean,sunrise,sunset," +
+		"rain_sum,precipitation_probability_max,wind_speed_10m_max,uv_index_max"
+
+	// openMeteoForecastHours and openMeteoForecastSlotHours shape GetForecastByCity like the OpenWeather 5 day / 3 hour forecast.
+	openMeteoForecastHours     = 120
+	openMeteoForecastSlotHours = 3
+	// openMeteoOneCallHours and openMeteoOneCallDays match the hourly and daily horizon of One Call.
+	openMeteoOneCallHours = 48
+	openMeteoOneCallDays  = 8
+)
+
+// OpenMeteoAdapter implements WeatherRepository and GeocodingRepository against the keyless Open-Meteo APIs.
+// Every request asks for metric units, m/s wind speeds and unix timestamps so results match OpenWeatherAdapter.
+type OpenMeteoAdapter struct {
+	client         *http.Client
+	forecastURL    string
+	geocodingURL   string
+	airQualityURL  string
+	archiveURL     string
+	circuitBreaker *circuitbreaker.CircuitBreaker
+	maxAttempts    int
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+}
+
+// OpenMeteoForecastResponse mirrors the /v1/forecast payload for the variables we request.
+type OpenMeteoForecastResponse struct {
+	Latitude         float32 `json:"latitude"`
+	Longitude        float32 `json:"longitude"`
+	Timezone         string  `json:"timezone"`
+	UTCOffsetSeconds int     `json:"utc_offset_seconds"`
+
+	Current *struct {
+		Time                int64   `json:"time"`
+		Temperature         float64 `json:"temperature_2m"`
+		ApparentTemperature float64 `json:"apparent_temperature"`
+		Humidity            int     `json:"relative_humidity_2m"`
+		DewPoint            float64 `json:"dew_point_2m"`
+		Pressure            float64 `json:"pressure_msl"`
+		CloudCover          int     `json:"cloud_cover"`
+		Visibility          float64 `json:"visibility"`
+		WindSpeed           float64 `json:"wind_speed_10m"`
+		WindDirection       int     `json:"wind_direction_10m"`
+		UVIndex             float64 `json:"uv_index"`
+		WeatherCode         int     `json:"weather_code"`
+	} `json:"current"`
+
+	Hourly *struct {
+		Time                     []int64   `json:"time"`
+		Temperature              []float64 `json:"temperature_2m"`
+		ApparentTemperature      []float64 `json:"apparent_temperature"`
+		Humidity                 []int     `json:"relative_humidity_2m"`
+		WindSpeed                []float64 `json:"wind_speed_10m"`
+		PrecipitationProbability []float64 `json:"precipitation_probability"`
+		WeatherCode              []int     `json:"weather_code"`
+	} `json:"hourly"`
+
+	Daily *struct {
+		Time                        []int64   `json:"time"`
+		WeatherCode                 []int     `json:"weather_code"`
+		TempMax                     []float64 `json:"temperature_2m_max"`
+		TempMin                     []float64 `json:"temperature_2m_min"`
+		HumidityMean                []float64 `json:"relative_humidity_2m_mean"`
+		Sunrise                     []int64   `json:"sunrise"`
+		Sunset                      []int64   `json:"sunset"`
+		RainSum                     []float64 `json:"rain_sum"`
+		PrecipitationProbabilityMax []float64 `json:"precipitation_probability_max"`
+		WindSpeedMax                []float64 `json:"wind_speed_10m_max"`
+		UVIndexMax                  []float64 `json:"uv_index_max"`
+	} `json:"daily"`
+}
+
+// openMeteoErrorResponse is the body Open-Meteo returns for rejected requests.
+type openMeteoErrorResponse struct {
+	Error  bool   `json:"error"`
+	Reason string `json:"reason"`
+}
+
+// NewOpenMeteoAdapter creates a new OpenMeteoAdapter whose breaker logs its state changes to logger.
+func NewOpenMeteoAdapter(cfg config.OpenMeteoConfig, logger *zap.Logger) *OpenMeteoAdapter {
+	return &OpenMeteoAdapter{
+		client:         &http.Client{Timeout: cfg.HTTPTimeout},
+		forecastURL:    cfg.ForecastURL,
+		geocodingURL:   cfg.GeocodingURL,
+		airQualityURL:  cfg.AirQualityURL,
+		archiveURL:     cfg.ArchiveURL,
+		circuitBreaker: circuitbreaker.NewCircuitBreakerWithSettings("open-meteo-api", cfg.CircuitBreaker, logger),
+		maxAttempts:    cfg.RetryMaxAttempts,
+		initialBackoff: cfg.RetryInitialBackoff,
+		maxBackoff:     cfg.RetryMaxBackoff,
+	}
+}
+
+// GetWeatherByCity ignores lang: descriptions come from the English WMO code table, and every
+// Open-Meteo entity reports language.English so clients can tell the request was not localized.
+func (a *OpenMeteoAdapter) GetWeatherByCity(ctx context.Context, city string, lang language.Code) (*entity.Weather, error) {
+	return circuitbreaker.Execute(ctx, a.circuitBreaker, func(ctx context.Context) (*entity.Weather, error) {
+		return a.fetchWeatherData(ctx, city)
+	})
+}
+
+// GetWeatherByCoordinates requests the current conditions at a coordinate. Open-Meteo has no reverse
+// geocoding, so the returned weather has no city name.
+func (a *OpenMeteoAdapter) GetWeatherByCoordinates(ctx context.Context, lat float32, lon float32, lang language.Code) (*entity.Weather, error) {
+	return circuitbreaker.Execute(ctx, a.circuitBreaker, func(ctx context.Context) (*entity.Weather, error) {
+		return a.fetchCurrentWeather(ctx, lat, lon, "")
+	})
+}
+
+// GetWeatherOverviewByLatLong is not offered by Open-Meteo, which has no text summaries.
+func (a *OpenMeteoAdapter) GetWeatherOverviewByLatLong(ctx context.Context, lon float32, lat float32, system units.System) (*entity.WeatherOverview, error) {
+	return nil, support.NewErrNotImplemented("weather overview is not available from the open-meteo provider")
+}
+
+func (a *OpenMeteoAdapter) GetForecastByCity(ctx context.Context, city string, lang language.Code) (*entity.Forecast, error) {
+	return circuitbreaker.Execute(ctx, a.circuitBreaker, func(ctx context.Context) (*entity.Forecast, error) {
+		return a.fetchForecastData(ctx, city)
+	})
+}
+
+// GetOneCall serves the current, hourly and daily blocks. Open-Meteo has no per-minute
+// precipitation or agency alerts, so explicitly asking for those blocks is reported as not implemented.
+// An empty include requests every supported block.
+func (a *OpenMeteoAdapter) GetOneCall(ctx context.Context, lat float32, lon float32, include []entity.OneCallBlock, lang language.Code) (*entity.OneCall, error) {
+	for _, block := range include {
+		if block == entity.OneCallMinutely || block == entity.OneCallAlerts {
+			return nil, support.NewErrNotImplemented(fmt.Sprintf("%s data is not available from the open-meteo provider", block))
+		}
+	}
+
+	return circuitbreaker.Execute(ctx, a.circuitBreaker, func(ctx context.Context) (*entity.OneCall, error) {
+		return a.fetchOneCallData(ctx, lat, lon, include)
+	})
+}
+
+// getJSON performs a GET with retry and decodes a successful JSON body into out.
+// Failed answers are mapped by statusError, with Open-Meteo's reason for rejecting a request.
+func (a *OpenMeteoAdapter) getJSON(ctx context.Context, endpoint string, out interface{}) error {
+	resp, err := getWithRetry(ctx, a.client, endpoint, a.maxAttempts, a.initialBackoff, a.maxBackoff, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		var apiErr openMeteoErrorResponse
+		_ = json.Unmarshal(body, &apiErr)
+
+		return statusError(resp, body, apiErr.Reason)
+	}
+
+	if err := json.Unmarshal(body, out); err != nil {
+		return fmt.Errorf("failed to decode successful response: %w", err)
+	}
+
+	return nil
+}
+
+// resolveCity looks a city up with the geocoding API, since the forecast API only accepts coordinates.
+// An OpenWeather style "name,country" query narrows the search to that ISO country code.
+func (a *OpenMeteoAdapter) resolveCity(ctx context.Context, city string) (*OpenMeteoPlace, error) {
+	places, err := a.searchPlaces(ctx, city, 1)
+	if err != nil {
+		return nil, err
+	}
+	if len(places) == 0 {
+		return nil, support.NewErrNotFound(fmt.Sprintf("city '%s' not found", city))
+	}
+
+	return &places[0], nil
+}
+
+// fetchWeatherData resolves the city and requests its current conditions.
+func (a *OpenMeteoAdapter) fetchWeatherData(ctx context.Context, city string) (*entity.Weather, error) {
+	place, err := a.resolveCity(ctx, city)
+	if err != nil {
+		return nil, err
+	}
+	return a.fetchCurrentWeather(ctx, place.Latitude, place.Longitude, place.Name)
+}
+
+// fetchCurrentWeather requests the current conditions at a coordinate, reported under city.
+func (a *OpenMeteoAdapter) fetchCurrentWeather(ctx context.Context, lat float32, lon float32, city string) (*entity.Weather, error) {
+	endpoint := fmt.Sprintf("%s/v1/forecast?latitude=%f&longitude=%f&current=%s&wind_speed_unit=ms&timeformat=unixtime",
+		a.forecastURL, lat, lon, openMeteoCurrentVariables)
+
+	var apiResp OpenMeteoForecastResponse
+	if err := a.getJSON(ctx, endpoint, &apiResp); err != nil {
+		return nil, err
+	}
+	if apiResp.Current == nil {
+		return nil, fmt.Errorf("open-meteo response is missing current conditions")
+	}
+
+	return &entity.Weather{
+		City:        city,
+		Temperature: apiResp.Current.Temperature,
+		Description: wmoDescription(apiResp.Current.WeatherCode),
+		Humidity:    apiResp.Current.Humidity,
+		WindSpeed:   apiResp.Current.WindSpeed,
+		Timestamp:   unixUTC(apiResp.Current.Time),
+		Provider:    config.ProviderOpenMeteo,
+		Units:       units.Metric,
+		Language:    language.English,
+	}, nil
+}
+
+// fetchForecastData resolves the city and samples the hourly forecast every openMeteoForecastSlotHours hours.
+func (a *OpenMeteoAdapter) fetchForecastData(ctx context.Context, city string) (*entity.Forecast, error) {
+	place, err := a.resolveCity(ctx, city)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint := fmt.Sprintf("%s/v1/forecast?latitude=%f&longitude=%f&hourly=%s&forecast_hours=%d&wind_speed_unit=ms&timeformat=unixtime",
+		a.forecastURL, place.Latitude, place.Longitude, openMeteoHourlyVariables, openMeteoForecastHours)
+
+	var apiResp OpenMeteoForecastResponse
+	if err := a.getJSON(ctx, endpoint, &apiResp); err != nil {
+		return nil, err
+	}
+
+	var slots []entity.ForecastSlot
+	for _, hour := range mapOpenMeteoHourly(&apiResp) {
+		if hour.Time.Unix()/3600%openMeteoForecastSlotHours != 0 {
+			continue
+		}
+		slots = append(slots, entity.ForecastSlot{
+			Time:                     hour.Time,
+			Temperature:              hour.Temperature,
+			FeelsLike:                hour.FeelsLike,
+			TempMin:                  hour.Temperature,
+			TempMax:                  hour.Temperature,
+			Description:              hour.Description,
+			Humidity:                 hour.Humidity,
+			WindSpeed:                hour.WindSpeed,
+			PrecipitationProbability: hour.PrecipitationProbability,
+		})
+	}
+
+	return &entity.Forecast{
+		City:     place.Name,
+		Country:  place.CountryCode,
+		Lat:      place.Latitude,
+		Lon:      place.Longitude,
+		Slots:    slots,
+		Provider: config.ProviderOpenMeteo,
+		Units:    units.Metric,
+		Language: language.English,
+	}, nil
+}
+
+// fetchOneCallData requests the forecast variables backing the wanted One Call blocks.
+func (a *OpenMeteoAdapter) fetchOneCallData(ctx context.Context, lat float32, lon float32, include []entity.OneCallBlock) (*entity.OneCall, error) {
+	wanted := make(map[entity.OneCallBlock]bool)
+	for _, block := range include {
+		wanted[block] = true
+	}
+	all := len(include) == 0
+
+	params := url.Values{}
+	params.Set("latitude", fmt.Sprintf("%f", lat))
+	params.Set("longitude", fmt.Sprintf("%f", lon))
+	params.Set("timezone", "auto")
+	params.Set("wind_speed_unit", "ms")
+	params.Set("timeformat", "unixtime")
+	forecastDays := 1
+	if all || wanted[entity.OneCallCurrent] {
+		params.Set("current", openMeteoCurrentVariables)
+		// Sunrise and sunset come from today's daily values
+		params.Set("daily", "sunrise,sunset")
+	}
+	if all || wanted[entity.OneCallHourly] {
+		params.Set("hourly", openMeteoHourlyVariables)
+		params.Set("forecast_hours", fmt.Sprintf("%d", openMeteoOneCallHours))
+		forecastDays = openMeteoOneCallHours/24 + 1
+	}
+	if all || wanted[entity.OneCallDaily] {
+		params.Set("daily", openMeteoDailyVariables)
+		forecastDays = openMeteoOneCallDays
+	}
+	params.Set("forecast_days", fmt.Sprintf("%d", forecastDays))
+
+	// url.Values escapes the commas in variable lists, which Open-Meteo accepts
+	endpoint := fmt.Sprintf("%s/v1/forecast?%s", a.forecastURL, params.Encode())
+
+	var apiResp OpenMeteoForecastResponse
+	if err := a.getJSON(ctx, endpoint, &apiResp); err != nil {
+		return nil, err
+	}
+
+	oneCall := &entity.OneCall{
+		Lat:            apiResp.Latitude,
+		Lon:            apiResp.Longitude,
+		Timezone:       apiResp.Timezone,
+		TimezoneOffset: apiResp.UTCOffsetSeconds,
+		Provider:       config.ProviderOpenMeteo,
+		Units:          units.Metric,
+		Language:       language.English,
+	}
+	if (all || wanted[entity.OneCallCurrent]) && apiResp.Current != nil {
+		oneCall.Current = mapOpenMeteoCurrent(&apiResp)
+	}
+	if all || wanted[entity.OneCallHourly] {
+		oneCall.Hourly = mapOpenMeteoHourly(&apiResp)
+	}
+	if all || wanted[entity.OneCallDaily] {
+		oneCall.Daily = mapOpenMeteoDaily(&apiResp)
+	}
+
+	return oneCall, nil
+}
+
+func mapOpenMeteoCurrent(apiResp *OpenMeteoForecastResponse) *entity.CurrentConditions {
+	c := apiResp.Current
+	current := &entity.CurrentConditions{
+		Time:        unixUTC(c.Time),
+		Temperature: c.Temperature,
+		FeelsLike:   c.ApparentTemperature,
+		Pressure:    int(c.Pressure),
+		Humidity:    c.Humidity,
+		DewPoint:    c.DewPoint,
+		UVIndex:     c.UVIndex,
+		Clouds:      c.CloudCover,
+		Visibility:  int(c.Visibility),
+		WindSpeed:   c.WindSpeed,
+		WindDeg:     c.WindDirection,
+		Description: wmoDescription(c.WeatherCode),
+	}
+	if d := apiResp.Daily; d != nil {
+		if sunrise := valueAt(d.Sunrise, 0); sunrise != 0 {
+			current.Sunrise = unixUTC(sunrise)
+		}
+		if sunset := valueAt(d.Sunset, 0); sunset != 0 {
+			current.Sunset = unixUTC(sunset)
+		}
+	}
+	return current
+}
+
+func mapOpenMeteoHourly(apiResp *OpenMeteoForecastResponse) []entity.HourlyConditions {
+	h := apiResp.Hourly
+	if h == nil {
+		return nil
+	}
+
+	hourly := make([]entity.HourlyConditions, 0, len(h.Time))
+	for i, t := range h.Time {
+		hourly = append(hourly, entity.HourlyConditions{
+			Time:                     unixUTC(t),
+			Temperature:              valueAt(h.Temperature, i),
+			FeelsLike:                valueAt(h.ApparentTemperature, i),
+			Humidity:                 valueAt(h.Humidity, i),
+			WindSpeed:                valueAt(h.WindSpeed, i),
+			Description:              wmoDescription(valueAt(h.WeatherCode, i)),
+			PrecipitationProbability: valueAt(h.PrecipitationProbability, i) / 100,
+		})
+	}
+	return hourly
+}
+
+// mapOpenMeteoDaily maps daily values. Open-Meteo has no day or night temperature,
+// so TempDay and TempNight carry the daily maximum and minimum.
+func mapOpenMeteoDaily(apiResp *OpenMeteoForecastResponse) []entity.DailyConditions {
+	d := apiResp.Daily
+	if d == nil {
+		return nil
+	}
+
+	daily := make([]entity.DailyConditions, 0, len(d.Time))
+	for i, t := range d.Time {
+		description := wmoDescription(valueAt(d.WeatherCode, i))
+		daily = append(daily, entity.DailyConditions{
+			Date:                     unixUTC(t),
+			Summary:                  strings.ToUpper(description[:1]) + description[1:],
+			TempMin:                  valueAt(d.TempMin, i),
+			TempMax:                  valueAt(d.TempMax, i),
+			TempDay:                  valueAt(d.TempMax, i),
+			TempNight:                valueAt(d.TempMin, i),
+			Humidity:                 int(valueAt(d.HumidityMean, i)),
+			WindSpeed:                valueAt(d.WindSpeedMax, i),
+			Description:              description,
+			PrecipitationProbability: valueAt(d.PrecipitationProbabilityMax, i) / 100,
+			Rain:                     valueAt(d.RainSum, i),
+			UVIndex:                  valueAt(d.UVIndexMax, i),
+		})
+	}
+	return daily
+}
+
+// valueAt returns values[i], or the zero value when Open-Meteo returned a shorter series.
+func valueAt[T any](values []T, i int) T {
+	var zero T
+	if i < 0 || i >= len(values) {
+		return zero
+	}
+	return values[i]
+}
+
+var _ repository.WeatherRepository = (*OpenMeteoAdapter)(nil)
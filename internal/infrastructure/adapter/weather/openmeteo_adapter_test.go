@@ -0,0 +1,275 @@
+package weather
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"weather-api/internal/core/domain/entity"
+	"weather-api/internal/infrastructure/support"
+	"weather-api/pkg/circuitbreaker"
+	"weather-api/pkg/language"
+	"weather-api/pkg/units"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newTestOpenMeteoAdapter points every Open-Meteo API at the same test server.
+func newTestOpenMeteoAdapter(serverURL string) *OpenMeteoAdapter {
+	return &OpenMeteoAdapter{
+		client:         &http.Client{Timeout: 10 * time.Second},
+		forecastURL:    serverURL,
+		geocodingURL:   serverURL,
+		airQualityURL:  serverURL,
+		archiveURL:     serverURL,
+		circuitBreaker: circuitbreaker.NewCircuitBreaker("test-open-meteo-api"),
+		maxAttempts:    1,
+	}
+}
+
+func TestOpenMeteoAdapter_GetWeatherByCity_Success(t *testing.T) {
+	// Arrange
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/v1/search":
+			assert.Equal(t, "paris", r.URL.Query().Get("name"))
+			assert.Equal(t, "FR", r.URL.Query().Get("countryCode"))
+			_, _ = w.Write([]byte(`{"results":[{"name":"Paris","latitude":48.85,"longitude":2.35,"country_code":"FR","admin1":"Île-de-France"}]}`))
+		case "/v1/forecast":
+			assert.Equal(t, "48.849998", r.URL.Query().Get("latitude"))
+			assert.Equal(t, "ms", r.URL.Query().Get("wind_speed_unit"))
+			_, _ = w.Write([]byte(`{"latitude":48.86,"longitude":2.34,"current":{"time":1704812400,"temperature_2m":7.5,"relative_humidity_2m":81,"wind_speed_10m":3.2,"weather_code":63}}`))
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	}))
+	defer mockServer.Close()
+
+	adapter := newTestOpenMeteoAdapter(mockServer.URL)
+
+	// Act
+	weather, err := adapter.GetWeatherByCity(context.Background(), "paris,fr", language.English)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "Paris", weather.City)
+	assert.Equal(t, 7.5, weather.Temperature)
+	assert.Equal(t, "moderate rain", weather.Description)
+	assert.Equal(t, 81, weather.Humidity)
+	assert.Equal(t, 3.2, weather.WindSpeed)
+	assert.Equal(t, time.Unix(1704812400, 0).UTC(), weather.Timestamp)
+}
+
+func TestOpenMeteoAdapter_GetWeatherByCity_NotFound(t *testing.T) {
+	// Arrange
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"generationtime_ms":0.5}`))
+	}))
+	defer mockServer.Close()
+
+	adapter := newTestOpenMeteoAdapter(mockServer.URL)
+
+	// Act
+	weather, err := adapter.GetWeatherByCity(context.Background(), "Atlantis", language.English)
+
+	// Assert
+	assert.Nil(t, weather)
+	assert.IsType(t, &support.ErrNotFound{}, err)
+	assert.Contains(t, err.Error(), "Atlantis")
+}
+
+func TestOpenMeteoAdapter_GetForecastByCity_SamplesThreeHourSlots(t *testing.T) {
+	// Arrange
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/v1/search":
+			_, _ = w.Write([]byte(`{"results":[{"name":"London","latitude":51.5,"longitude":-0.12,"country_code":"GB"}]}`))
+		case "/v1/forecast":
+			assert.Equal(t, "120", r.URL.Query().Get("forecast_hours"))
+			// 2024-01-09 11:00 to 14:00 UTC
+			_, _ = w.Write([]byte(`{"hourly":{
+				"time":[1704798000,1704801600,1704805200,1704808800],
+				"temperature_2m":[5,6,7,8],
+				"apparent_temperature":[3,4,5,6],
+				"relative_humidity_2m":[90,85,80,75],
+				"wind_speed_10m":[2,3,4,5],
+				"precipitation_probability":[10,20,30,40],
+				"weather_code":[0,1,2,3]}}`))
+		}
+	}))
+	defer mockServer.Close()
+
+	adapter := newTestOpenMeteoAdapter(mockServer.URL)
+
+	// Act
+	forecast, err := adapter.GetForecastByCity(context.Background(), "London", language.English)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "London", forecast.City)
+	assert.Equal(t, "GB", forecast.Country)
+	assert.Len(t, forecast.Slots, 1)
+	slot := forecast.Slots[0]
+	assert.Equal(t, time.Date(2024, 1, 9, 12, 0, 0, 0, time.UTC), slot.Time)
+	assert.Equal(t, 6.0, slot.Temperature)
+	assert.Equal(t, "mainly clear", slot.Description)
+	assert.InDelta(t, 0.2, slot.PrecipitationProbability, 1e-9)
+}
+
+func TestOpenMeteoAdapter_GetOneCall_UnsupportedBlocks(t *testing.T) {
+	// Arrange
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected upstream request %s", r.URL)
+	}))
+	defer mockServer.Close()
+
+	adapter := newTestOpenMeteoAdapter(mockServer.URL)
+
+	// Act
+	oneCall, err := adapter.GetOneCall(context.Background(), 51.5, -0.12, []entity.OneCallBlock{entity.OneCallAlerts}, language.English)
+
+	// Assert
+	assert.Nil(t, oneCall)
+	assert.IsType(t, &support.ErrNotImplemented{}, err)
+}
+
+func TestOpenMeteoAdapter_GetOneCall_DailyOnly(t *testing.T) {
+	// Arrange
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		assert.Empty(t, query.Get("current"))
+		assert.Empty(t, query.Get("hourly"))
+		assert.Equal(t, openMeteoDailyVariables, query.Get("daily"))
+		assert.Equal(t, "8", query.Get("forecast_days"))
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"latitude":51.5,"longitude":-0.12,"timezone":"Europe/London","utc_offset_seconds":0,
+			"daily":{"time":[1704758400],"weather_code":[61],"temperature_2m_max":[9],"temperature_2m_min":[2],
+			"relative_humidity_2m_mean":[77],"rain_sum":[1.4],"precipitation_probability_max":[65],"wind_speed_10m_max":[6.1],"uv_index_max":[1.2]}}`))
+	}))
+	defer mockServer.Close()
+
+	adapter := newTestOpenMeteoAdapter(mockServer.URL)
+
+	// Act
+	oneCall, err := adapter.GetOneCall(context.Background(), 51.5, -0.12, []entity.OneCallBlock{entity.OneCallDaily}, language.English)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "Europe/London", oneCall.Timezone)
+	assert.Nil(t, oneCall.Current)
+	assert.Nil(t, oneCall.Hourly)
+	assert.Len(t, oneCall.Daily, 1)
+	day := oneCall.Daily[0]
+	assert.Equal(t, "slight rain", day.Description)
+	assert.Equal(t, "Slight rain", day.Summary)
+	assert.Equal(t, 2.0, day.TempMin)
+	assert.Equal(t, 9.0, day.TempMax)
+	assert.Equal(t, 77, day.Humidity)
+	assert.InDelta(t, 0.65, day.PrecipitationProbability, 1e-9)
+}
+
+func TestOpenMeteoAdapter_GetAirQualityHistory_FiltersWindow(t *testing.T) {
+	// Arrange
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/air-quality", r.URL.Path)
+		assert.Equal(t, "2024-01-09", r.URL.Query().Get("start_date"))
+		assert.Equal(t, "2024-01-09", r.URL.Query().Get("end_date"))
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"latitude":51.5,"longitude":-0.12,"hourly":{
+			"time":[1704798000,1704801600,1704805200],
+			"european_aqi":[15,45,95],
+			"pm2_5":[4,12,60],"pm10":[8,20,90],"carbon_monoxide":[200,210,300],
+			"nitrogen_dioxide":[10,12,40],"sulphur_dioxide":[1,2,3],"ozone":[50,55,20],"ammonia":[0.5,0.6,0.7]}}`))
+	}))
+	defer mockServer.Close()
+
+	adapter := newTestOpenMeteoAdapter(mockServer.URL)
+	start := time.Date(2024, 1, 9, 12, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 1, 9, 13, 0, 0, 0, time.UTC)
+
+	// Act
+	airQuality, err := adapter.GetAirQualityHistory(context.Background(), 51.5, -0.12, start, end)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, airQuality.Readings, 2)
+	assert.Equal(t, 3, airQuality.Readings[0].AQI)
+	assert.Equal(t, 12.0, airQuality.Readings[0].Components.PM2_5)
+	assert.Equal(t, 5, airQuality.Readings[1].AQI)
+	assert.Equal(t, 0.7, airQuality.Readings[1].Components.NH3)
+}
+
+func TestOpenMeteoAdapter_GetDaySummary_SamplesLocalHours(t *testing.T) {
+	// Arrange
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/archive", r.URL.Path)
+		assert.Equal(t, "auto", r.URL.Query().Get("timezone"))
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"latitude":38.4,"longitude":27.1,"timezone":"Europe/Istanbul",
+			"hourly":{"time":[0],"temperature_2m":[0,1,2,3,4,5,6,7,8,9,10,11,12,13,14,15,16,17,18,19,20,21,22,23],
+				"relative_humidity_2m":[0,0,0,0,0,0,0,0,0,0,0,0,55]},
+			"daily":{"time":[1704747600],"temperature_2m_max":[23],"temperature_2m_min":[0],"precipitation_sum":[2.5],
+				"wind_speed_10m_max":[7.2],"wind_direction_10m_dominant":[200]}}`))
+	}))
+	defer mockServer.Close()
+
+	adapter := newTestOpenMeteoAdapter(mockServer.URL)
+
+	// Act
+	summary, err := adapter.GetDaySummary(context.Background(), 38.4, 27.1, time.Date(2024, 1, 9, 0, 0, 0, 0, time.UTC))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 1, 9, 0, 0, 0, 0, time.UTC), summary.Date)
+	assert.Equal(t, 6.0, summary.TempMorning)
+	assert.Equal(t, 12.0, summary.TempAfternoon)
+	assert.Equal(t, 18.0, summary.TempEvening)
+	assert.Equal(t, 0.0, summary.TempNight)
+	assert.Equal(t, 55.0, summary.HumidityAfternoon)
+	assert.Equal(t, 2.5, summary.Precipitation)
+	assert.Equal(t, 200.0, summary.WindMaxDirection)
+}
+
+func TestOpenMeteoAdapter_RejectedRequestIsBadRequest(t *testing.T) {
+	// Arrange
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"error":true,"reason":"Parameter 'start_date' is out of allowed range"}`))
+	}))
+	defer mockServer.Close()
+
+	adapter := newTestOpenMeteoAdapter(mockServer.URL)
+
+	// Act
+	historical, err := adapter.GetHistoricalWeather(context.Background(), 38.4, 27.1, time.Date(1900, 1, 1, 0, 0, 0, 0, time.UTC), language.English)
+
+	// Assert
+	assert.Nil(t, historical)
+	assert.IsType(t, &support.ErrBadRequest{}, err)
+	assert.Contains(t, err.Error(), "start_date")
+}
+
+func TestOpenMeteoAdapter_UnsupportedOperations(t *testing.T) {
+	adapter := newTestOpenMeteoAdapter("http://127.0.0.1:0")
+
+	_, overviewErr := adapter.GetWeatherOverviewByLatLong(context.Background(), 2.35, 48.85, units.Metric)
+	_, reverseErr := adapter.ReverseGeocode(context.Background(), 48.85, 2.35, 1)
+
+	assert.IsType(t, &support.ErrNotImplemented{}, overviewErr)
+	assert.IsType(t, &support.ErrNotImplemented{}, reverseErr)
+}
+
+func TestWMODescription(t *testing.T) {
+	assert.Equal(t, "clear sky", wmoDescription(0))
+	assert.Equal(t, "thunderstorm with heavy hail", wmoDescription(99))
+	assert.Equal(t, "unknown", wmoDescription(42))
+}
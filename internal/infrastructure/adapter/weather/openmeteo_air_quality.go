@@ -0,0 +1,146 @@
+package weather
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"weather-api/internal/core/domain/entity"
+	"weather-api/internal/infrastructure/config"
+	"weather-api/pkg/circuitbreaker"
+)
+
+const (
+	// openMeteoAirQualityVariables are the pollutants requested from the air quality API.
+	// Open-Meteo does not report nitrogen monoxide, so AirComponents.NO stays zero.
+	openMeteoAirQualityVariables = "european_aqi,carbon_monoxide,nitrogen_dioxide,ozone,sulphur_dioxide,pm2_5,pm10,ammonia"
+	// openMeteoAirQualityForecastDays matches the four day horizon of the OpenWeather air pollution forecast.
+	openMeteoAirQualityForecastDays = 4
+)
+
+// openMeteoAirValues are the pollutant fields shared by the current and hourly air quality blocks.
+type openMeteoAirValues[T any] struct {
+	EuropeanAQI T `json:"european_aqi"`
+	CO          T `json:"carbon_monoxide"`
+	NO2         T `json:"nitrogen_dioxide"`
+	O3          T `json:"ozone"`
+	SO2         T `json:"sulphur_dioxide"`
+	PM2_5       T `json:"pm2_5"`
+	PM10        T `json:"pm10"`
+	NH3         T `json:"ammonia"`
+}
+
+// OpenMeteoAirQualityResponse mirrors the /v1/air-quality payload.
+type OpenMeteoAirQualityResponse struct {
+	Latitude  float32 `json:"latitude"`
+	Longitude float32 `json:"longitude"`
+
+	Current *struct {
+		Time int64 `json:"time"`
+		openMeteoAirValues[float64]
+	} `json:"current"`
+
+	Hourly *struct {
+		Time []int64 `json:"time"`
+		openMeteoAirValues[[]float64]
+	} `json:"hourly"`
+}
+
+func (a *OpenMeteoAdapter) GetAirQuality(ctx context.Context, lat float32, lon float32) (*entity.AirQuality, error) {
+	endpoint := fmt.Sprintf("%s/v1/air-quality?latitude=%f&longitude=%f&current=%s&timeformat=unixtime",
+		a.airQualityURL, lat, lon, openMeteoAirQualityVariables)
+	return a.executeAirQuality(ctx, endpoint, time.Time{}, time.Time{})
+}
+
+func (a *OpenMeteoAdapter) GetAirQualityForecast(ctx context.Context, lat float32, lon float32) (*entity.AirQuality, error) {
+	endpoint := fmt.Sprintf("%s/v1/air-quality?latitude=%f&longitude=%f&hourly=%s&forecast_days=%d&timeformat=unixtime",
+		a.airQualityURL, lat, lon, openMeteoAirQualityVariables, openMeteoAirQualityForecastDays)
+	return a.executeAirQuality(ctx, endpoint, time.Time{}, time.Time{})
+}
+
+// GetAirQualityHistory requests whole UTC days covering [start, end] and keeps the readings inside the window.
+func (a *OpenMeteoAdapter) GetAirQualityHistory(ctx context.Context, lat float32, lon float32, start time.Time, end time.Time) (*entity.AirQuality, error) {
+	endpoint := fmt.Sprintf("%s/v1/air-quality?latitude=%f&longitude=%f&hourly=%s&start_date=%s&end_date=%s&timeformat=unixtime",
+		a.airQualityURL, lat, lon, openMeteoAirQualityVariables,
+		start.UTC().Format(daySummaryDateLayout), end.UTC().Format(daySummaryDateLayout))
+	return a.executeAirQuality(ctx, endpoint, start, end)
+}
+
+// executeAirQuality runs an air quality request through the circuit breaker.
+// Non-zero from and to bound the returned hourly readings.
+func (a *OpenMeteoAdapter) executeAirQuality(ctx context.Context, endpoint string, from time.Time, to time.Time) (*entity.AirQuality, error) {
+	return circuitbreaker.Execute(ctx, a.circuitBreaker, func(ctx context.Context) (*entity.AirQuality, error) {
+		return a.fetchAirQualityData(ctx, endpoint, from, to)
+	})
+}
+
+// fetchAirQualityData requests an air quality endpoint and maps the current or hourly block.
+func (a *OpenMeteoAdapter) fetchAirQualityData(ctx context.Context, endpoint string, from time.Time, to time.Time) (*entity.AirQuality, error) {
+	var apiResp OpenMeteoAirQualityResponse
+	if err := a.getJSON(ctx, endpoint, &apiResp); err != nil {
+		return nil, err
+	}
+
+	airQuality := &entity.AirQuality{
+		Lat:      apiResp.Latitude,
+		Lon:      apiResp.Longitude,
+		Provider: config.ProviderOpenMeteo,
+	}
+
+	if c := apiResp.Current; c != nil {
+		airQuality.Readings = append(airQuality.Readings, entity.AirQualityReading{
+			Time: unixUTC(c.Time),
+			AQI:  europeanAQIToScale(c.EuropeanAQI),
+			Components: entity.AirComponents{
+				CO:    c.CO,
+				NO2:   c.NO2,
+				O3:    c.O3,
+				SO2:   c.SO2,
+				PM2_5: c.PM2_5,
+				PM10:  c.PM10,
+				NH3:   c.NH3,
+			},
+		})
+	}
+
+	if h := apiResp.Hourly; h != nil {
+		for i, t := range h.Time {
+			readingTime := unixUTC(t)
+			if (!from.IsZero() && readingTime.Before(from)) || (!to.IsZero() && readingTime.After(to)) {
+				continue
+			}
+			airQuality.Readings = append(airQuality.Readings, entity.AirQualityReading{
+				Time: readingTime,
+				AQI:  europeanAQIToScale(valueAt(h.EuropeanAQI, i)),
+				Components: entity.AirComponents{
+					CO:    valueAt(h.CO, i),
+					NO2:   valueAt(h.NO2, i),
+					O3:    valueAt(h.O3, i),
+					SO2:   valueAt(h.SO2, i),
+					PM2_5: valueAt(h.PM2_5, i),
+					PM10:  valueAt(h.PM10, i),
+					NH3:   valueAt(h.NH3, i),
+				},
+			})
+		}
+	}
+
+	return airQuality, nil
+}
+
+// europeanAQIToScale converts the European AQI (0-100+) into the 1-5 scale used by entity.AirQualityReading,
+// using the index's own good / fair / moderate / poor / very poor bands.
+func europeanAQIToScale(aqi float64) int {
+	switch {
+	case aqi <= 20:
+		return 1
+	case aqi <= 40:
+		return 2
+	case aqi <= 60:
+		return 3
+	case aqi <= 80:
+		return 4
+	default:
+		return 5
+	}
+}
@@ -0,0 +1,84 @@
+package weather
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"weather-api/internal/core/domain/entity"
+	"weather-api/internal/core/domain/repository"
+	"weather-api/internal/infrastructure/support"
+	"weather-api/pkg/circuitbreaker"
+)
+
+// OpenMeteoPlace mirrors one entry of the geocoding search payload.
+type OpenMeteoPlace struct {
+	Name        string  `json:"name"`
+	Latitude    float32 `json:"latitude"`
+	Longitude   float32 `json:"longitude"`
+	CountryCode string  `json:"country_code"`
+	Admin1      string  `json:"admin1"`
+}
+
+// OpenMeteoGeocodingResponse mirrors the geocoding search payload; results is absent when nothing matched.
+type OpenMeteoGeocodingResponse struct {
+	Results []OpenMeteoPlace `json:"results"`
+}
+
+func (a *OpenMeteoAdapter) SearchLocations(ctx context.Context, query string, limit int) ([]entity.Location, error) {
+	return circuitbreaker.Execute(ctx, a.circuitBreaker, func(ctx context.Context) ([]entity.Location, error) {
+		return a.fetchLocations(ctx, query, limit)
+	})
+}
+
+// ReverseGeocode is not offered by Open-Meteo, whose geocoding API only searches by name.
+func (a *OpenMeteoAdapter) ReverseGeocode(ctx context.Context, lat float32, lon float32, limit int) ([]entity.Location, error) {
+	return nil, support.NewErrNotImplemented("reverse geocoding is not available from the open-meteo provider")
+}
+
+// fetchLocations searches places and maps them, reporting an empty match list as not found.
+func (a *OpenMeteoAdapter) fetchLocations(ctx context.Context, query string, limit int) ([]entity.Location, error) {
+	places, err := a.searchPlaces(ctx, query, limit)
+	if err != nil {
+		return nil, err
+	}
+	if len(places) == 0 {
+		return nil, support.NewErrNotFound(fmt.Sprintf("location '%s' not found", query))
+	}
+
+	locations := make([]entity.Location, 0, len(places))
+	for _, place := range places {
+		locations = append(locations, entity.Location{
+			Name:    place.Name,
+			Country: place.CountryCode,
+			State:   place.Admin1,
+			Lat:     place.Latitude,
+			Lon:     place.Longitude,
+		})
+	}
+
+	return locations, nil
+}
+
+// searchPlaces queries the geocoding API. Open-Meteo matches on the place name only, so an
+// OpenWeather style "Paris,TX,US" query is split and a trailing two-letter part becomes the country filter.
+func (a *OpenMeteoAdapter) searchPlaces(ctx context.Context, query string, limit int) ([]OpenMeteoPlace, error) {
+	parts := strings.Split(query, ",")
+	name := strings.TrimSpace(parts[0])
+	endpoint := fmt.Sprintf("%s/v1/search?name=%s&count=%d&format=json", a.geocodingURL, url.QueryEscape(name), limit)
+	if len(parts) > 1 {
+		if country := strings.TrimSpace(parts[len(parts)-1]); len(country) == 2 {
+			endpoint += "&countryCode=" + url.QueryEscape(strings.ToUpper(country))
+		}
+	}
+
+	var apiResp OpenMeteoGeocodingResponse
+	if err := a.getJSON(ctx, endpoint, &apiResp); err != nil {
+		return nil, err
+	}
+
+	return apiResp.Results, nil
+}
+
+var _ repository.GeocodingRepository = (*OpenMeteoAdapter)(nil)
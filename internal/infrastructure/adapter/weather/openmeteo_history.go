@@ -0,0 +1,164 @@
+package weather
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"weather-api/internal/core/domain/entity"
+	"weather-api/internal/infrastructure/config"
+	"weather-api/internal/infrastructure/support"
+	"weather-api/pkg/circuitbreaker"
+	"weather-api/pkg/language"
+	"weather-api/pkg/units"
+)
+
+const (
+	// openMeteoArchiveHourlyVariables are requested for historical observations and day summaries.
+	openMeteoArchiveHourlyVariables = "temperature_2m,apparent_temperature,pressure_msl,relative_humidity_2m,dew_point_2m," +
+		"cloud_cover,wind_speed_10m,wind_direction_10m,weather_code"
+	// openMeteoArchiveDailyVariables are requested for day summaries.
+	openMeteoArchiveDailyVariables = "temperature_2m_max,temperature_2m_min,precipitation_sum,wind_speed_10m_max,wind_direction_10m_dominant"
+)
+
+// Local hours sampled for the morning, afternoon, evening and night values of a day summary,
+// matching the times OpenWeather uses for day_summary.
+const (
+	daySummaryNightHour     = 0
+	daySummaryMorningHour   = 6
+	daySummaryAfternoonHour = 12
+	daySummaryEveningHour   = 18
+)
+
+// OpenMeteoArchiveResponse mirrors the /v1/archive payload.
+type OpenMeteoArchiveResponse struct {
+	Latitude  float32 `json:"latitude"`
+	Longitude float32 `json:"longitude"`
+	Timezone  string  `json:"timezone"`
+
+	Hourly *struct {
+		Time                []int64   `json:"time"`
+		Temperature         []float64 `json:"temperature_2m"`
+		ApparentTemperature []float64 `json:"apparent_temperature"`
+		Pressure            []float64 `json:"pressure_msl"`
+		Humidity            []float64 `json:"relative_humidity_2m"`
+		DewPoint            []float64 `json:"dew_point_2m"`
+		CloudCover          []float64 `json:"cloud_cover"`
+		WindSpeed           []float64 `json:"wind_speed_10m"`
+		WindDirection       []float64 `json:"wind_direction_10m"`
+		WeatherCode         []int     `json:"weather_code"`
+	} `json:"hourly"`
+
+	Daily *struct {
+		Time             []int64   `json:"time"`
+		TempMax          []float64 `json:"temperature_2m_max"`
+		TempMin          []float64 `json:"temperature_2m_min"`
+		PrecipitationSum []float64 `json:"precipitation_sum"`
+		WindSpeedMax     []float64 `json:"wind_speed_10m_max"`
+		WindDirection    []float64 `json:"wind_direction_10m_dominant"`
+	} `json:"daily"`
+}
+
+func (a *OpenMeteoAdapter) GetHistoricalWeather(ctx context.Context, lat float32, lon float32, at time.Time, lang language.Code) (*entity.HistoricalWeather, error) {
+	return circuitbreaker.Execute(ctx, a.circuitBreaker, func(ctx context.Context) (*entity.HistoricalWeather, error) {
+		return a.fetchHistoricalWeatherData(ctx, lat, lon, at)
+	})
+}
+
+func (a *OpenMeteoAdapter) GetDaySummary(ctx context.Context, lat float32, lon float32, date time.Time) (*entity.DaySummary, error) {
+	return circuitbreaker.Execute(ctx, a.circuitBreaker, func(ctx context.Context) (*entity.DaySummary, error) {
+		return a.fetchDaySummaryData(ctx, lat, lon, date)
+	})
+}
+
+// fetchHistoricalWeatherData requests the archived hours of the UTC day containing at
+// and returns the observation closest to it, like the One Call timemachine endpoint.
+func (a *OpenMeteoAdapter) fetchHistoricalWeatherData(ctx context.Context, lat float32, lon float32, at time.Time) (*entity.HistoricalWeather, error) {
+	day := at.UTC().Format(daySummaryDateLayout)
+	endpoint := fmt.Sprintf("%s/v1/archive?latitude=%f&longitude=%f&start_date=%s&end_date=%s&hourly=%s&wind_speed_unit=ms&timeformat=unixtime",
+		a.archiveURL, lat, lon, day, day, openMeteoArchiveHourlyVariables)
+
+	var apiResp OpenMeteoArchiveResponse
+	if err := a.getJSON(ctx, endpoint, &apiResp); err != nil {
+		return nil, err
+	}
+
+	h := apiResp.Hourly
+	if h == nil || len(h.Time) == 0 {
+		return nil, support.NewErrNotFound(fmt.Sprintf("history for lat '%f' , lon '%f' not found", lat, lon))
+	}
+
+	closest := 0
+	for i, t := range h.Time {
+		if absDuration(unixUTC(t).Sub(at)) < absDuration(unixUTC(h.Time[closest]).Sub(at)) {
+			closest = i
+		}
+	}
+
+	return &entity.HistoricalWeather{
+		Lat:      apiResp.Latitude,
+		Lon:      apiResp.Longitude,
+		Timezone: apiResp.Timezone,
+		Provider: config.ProviderOpenMeteo,
+		Units:    units.Metric,
+		Language: language.English,
+		Observations: []entity.HistoricalObservation{{
+			Time:        unixUTC(h.Time[closest]),
+			Temperature: valueAt(h.Temperature, closest),
+			FeelsLike:   valueAt(h.ApparentTemperature, closest),
+			Pressure:    int(valueAt(h.Pressure, closest)),
+			Humidity:    int(valueAt(h.Humidity, closest)),
+			DewPoint:    valueAt(h.DewPoint, closest),
+			Clouds:      int(valueAt(h.CloudCover, closest)),
+			WindSpeed:   valueAt(h.WindSpeed, closest),
+			WindDeg:     int(valueAt(h.WindDirection, closest)),
+			Description: wmoDescription(valueAt(h.WeatherCode, closest)),
+		}},
+	}, nil
+}
+
+// fetchDaySummaryData requests one archived day in the location's own timezone and
+// derives the day summary from its daily aggregates and sampled local hours.
+func (a *OpenMeteoAdapter) fetchDaySummaryData(ctx context.Context, lat float32, lon float32, date time.Time) (*entity.DaySummary, error) {
+	day := date.Format(daySummaryDateLayout)
+	endpoint := fmt.Sprintf("%s/v1/archive?latitude=%f&longitude=%f&start_date=%s&end_date=%s&hourly=%s&daily=%s&timezone=auto&wind_speed_unit=ms&timeformat=unixtime",
+		a.archiveURL, lat, lon, day, day, openMeteoArchiveHourlyVariables, openMeteoArchiveDailyVariables)
+
+	var apiResp OpenMeteoArchiveResponse
+	if err := a.getJSON(ctx, endpoint, &apiResp); err != nil {
+		return nil, err
+	}
+
+	d, h := apiResp.Daily, apiResp.Hourly
+	if d == nil || h == nil || len(d.Time) == 0 {
+		return nil, support.NewErrNotFound(fmt.Sprintf("day summary for '%s' not found", day))
+	}
+
+	summaryDate, _ := time.Parse(daySummaryDateLayout, day)
+
+	return &entity.DaySummary{
+		Lat:                 apiResp.Latitude,
+		Lon:                 apiResp.Longitude,
+		Date:                summaryDate,
+		TempMin:             valueAt(d.TempMin, 0),
+		TempMax:             valueAt(d.TempMax, 0),
+		TempMorning:         valueAt(h.Temperature, daySummaryMorningHour),
+		TempAfternoon:       valueAt(h.Temperature, daySummaryAfternoonHour),
+		TempEvening:         valueAt(h.Temperature, daySummaryEveningHour),
+		TempNight:           valueAt(h.Temperature, daySummaryNightHour),
+		HumidityAfternoon:   valueAt(h.Humidity, daySummaryAfternoonHour),
+		CloudCoverAfternoon: valueAt(h.CloudCover, daySummaryAfternoonHour),
+		PressureAfternoon:   valueAt(h.Pressure, daySummaryAfternoonHour),
+		Precipitation:       valueAt(d.PrecipitationSum, 0),
+		WindMaxSpeed:        valueAt(d.WindSpeedMax, 0),
+		WindMaxDirection:    valueAt(d.WindDirection, 0),
+		Units:               units.Metric,
+	}, nil
+}
+
+func absDuration(d time.Duration) time.Duration {
+	if d < 0 {
+		return -d
+	}
+	return d
+}
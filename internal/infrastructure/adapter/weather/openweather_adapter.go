@@ -3,11 +3,10 @@ package weather
 import (
 	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"io"
-	"net"
 	"net/http"
+	"net/url"
 	"time"
 
 	"weather-api/internal/core/domain/entity"
@@ -15,16 +14,47 @@ import (
 	"weather-api/internal/infrastructure/config"
 	"weather-api/internal/infrastructure/support"
 	"weather-api/pkg/circuitbreaker"
+	"weather-api/pkg/language"
+	"weather-api/pkg/units"
+
+	"go.uber.org/zap"
+)
+
+// OpenWeather endpoints guarded by their own circuit breaker, so that an outage of one endpoint, such
+// as One Call 3.0, does not reject calls to the others. The current, forecast and history air pollution
+// endpoints are one service and share a breaker, as do direct and reverse geocoding.
+const (
+	openWeatherAPIWeather            = "weather"
+	openWeatherAPIForecast           = "forecast"
+	openWeatherAPIAirPollution       = "air-pollution"
+	openWeatherAPIOneCall            = "onecall"
+	openWeatherAPIOneCallOverview    = "onecall-overview"
+	openWeatherAPIOneCallTimemachine = "onecall-timemachine"
+	openWeatherAPIOneCallDaySummary  = "onecall-day-summary"
+	openWeatherAPIGeocoding          = "geocoding"
 )
 
+// openWeatherAPIs lists the guarded endpoints in the order their breakers are reported.
+var openWeatherAPIs = []string{
+	openWeatherAPIWeather,
+	openWeatherAPIForecast,
+	openWeatherAPIAirPollution,
+	openWeatherAPIOneCall,
+	openWeatherAPIOneCallOverview,
+	openWeatherAPIOneCallTimemachine,
+	openWeatherAPIOneCallDaySummary,
+	openWeatherAPIGeocoding,
+}
+
 type OpenWeatherAdapter struct {
 	client         *http.Client
 	apiKey         string
 	baseURL        string
-	circuitBreaker *circuitbreaker.CircuitBreaker
+	breakers       map[string]*circuitbreaker.CircuitBreaker
 	maxAttempts    int
 	initialBackoff time.Duration
 	maxBackoff     time.Duration
+	quota          *QuotaManager
 }
 
 type OpenWeatherResponse struct {
@@ -56,17 +86,50 @@ type OpenWeatherOverviewResponse struct {
 	WeatherOverview string  `json:"weather_overview"`
 }
 
+// OpenWeatherForecastResponse mirrors the 5 day / 3 hour forecast payload.
+type OpenWeatherForecastResponse struct {
+	List []struct {
+		Dt   int64 `json:"dt"`
+		Main struct {
+			Temp      float64 `json:"temp"`
+			FeelsLike float64 `json:"feels_like"`
+			TempMin   float64 `json:"temp_min"`
+			TempMax   float64 `json:"temp_max"`
+			Humidity  int     `json:"humidity"`
+		} `json:"main"`
+		Weather []struct {
+			Description string `json:"description"`
+		} `json:"weather"`
+		Wind struct {
+			Speed float64 `json:"speed"`
+		} `json:"wind"`
+		Pop float64 `json:"pop"`
+	} `json:"list"`
+
+	City struct {
+		Name    string `json:"name"`
+		Country string `json:"country"`
+		Coord   struct {
+			Lat float32 `json:"lat"`
+			Lon float32 `json:"lon"`
+		} `json:"coord"`
+	} `json:"city"`
+}
+
 // NewOpenWeatherAdapter creates a new OpenWeatherAdapter.
+// Every outgoing call is counted against quota, which may be nil for no budgets, and breaker state
+// changes are logged to logger.
 // nolint: unused
-func NewOpenWeatherAdapterWithConfig(cfg config.WeatherConfig) *OpenWeatherAdapter {
+func NewOpenWeatherAdapterWithConfig(cfg config.WeatherConfig, quota *QuotaManager, logger *zap.Logger) *OpenWeatherAdapter {
 	return &OpenWeatherAdapter{
 		client:         &http.Client{Timeout: cfg.HTTPTimeout},
 		apiKey:         cfg.APIKey,
 		baseURL:        cfg.BaseURL,
-		circuitBreaker: circuitbreaker.NewCircuitBreaker("openweather-api"),
+		breakers:       newOpenWeatherBreakers(cfg.CircuitBreaker, logger),
 		maxAttempts:    cfg.RetryMaxAttempts,
 		initialBackoff: cfg.RetryInitialBackoff,
 		maxBackoff:     cfg.RetryMaxBackoff,
+		quota:          quota,
 	}
 }
 
@@ -78,86 +141,101 @@ func NewOpenWeatherAdapter(apiKey string) *OpenWeatherAdapter {
 			Timeout: 10 * time.Second},
 		apiKey:         apiKey,
 		baseURL:        "https://api.openweathermap.org",
-		circuitBreaker: circuitbreaker.NewCircuitBreaker("openweather-api"),
+		breakers:       newOpenWeatherBreakers(circuitbreaker.DefaultSettings(), nil),
 		maxAttempts:    2,
 		initialBackoff: 200 * time.Millisecond,
 		maxBackoff:     2 * time.Second,
 	}
 }
 
-func (a *OpenWeatherAdapter) doGetWithRetry(url string) (*http.Response, error) {
-	var attempt int
-	backoff := a.initialBackoff
-	for {
-		resp, err := a.client.Get(url)
-		if err != nil {
-			// Wrap timeout-style errors with a clear prefix
-			var ne net.Error
-			if errors.Is(err, context.DeadlineExceeded) || (errors.As(err, &ne) && ne.Timeout()) {
-				return nil, fmt.Errorf("timeout: %w", err)
-			}
-			return nil, err
-		}
-		if resp.StatusCode < 500 {
-			return resp, nil
-		}
-		attempt++
-		if attempt >= a.maxAttempts {
-			return resp, nil
-		}
-		if backoff > a.maxBackoff {
-			backoff = a.maxBackoff
-		}
-		time.Sleep(backoff)
-		backoff *= 2
+// newOpenWeatherBreakers creates one breaker per guarded OpenWeather endpoint, named openweather-<api>.
+func newOpenWeatherBreakers(settings circuitbreaker.Settings, logger *zap.Logger) map[string]*circuitbreaker.CircuitBreaker {
+	breakers := make(map[string]*circuitbreaker.CircuitBreaker)
+	for _, api := range openWeatherAPIs {
+		breakers[api] = circuitbreaker.NewCircuitBreakerWithSettings("openweather-"+api, settings, logger)
 	}
+	return breakers
 }
 
-func (a *OpenWeatherAdapter) GetWeatherByCity(city string) (*entity.Weather, error) {
-	ctx := context.Background()
+// doGetWithRetry performs a GET with retry, reserving every attempt against the endpoint's quota.
+func (a *OpenWeatherAdapter) doGetWithRetry(ctx context.Context, url string) (*http.Response, error) {
+	endpoint := quotaEndpoint(url)
+	return getWithRetry(ctx, a.client, url, a.maxAttempts, a.initialBackoff, a.maxBackoff, func() error {
+		return a.quota.Reserve(endpoint)
+	})
+}
+
+func (a *OpenWeatherAdapter) GetWeatherByCity(ctx context.Context, city string, lang language.Code) (*entity.Weather, error) {
+	return circuitbreaker.Execute(ctx, a.breakers[openWeatherAPIWeather], func(ctx context.Context) (*entity.Weather, error) {
+		return a.fetchWeatherData(ctx, "q="+url.QueryEscape(city), fmt.Sprintf("city '%s' not found", city), lang)
+	})
+}
+
+// GetWeatherByCoordinates uses the same current weather API as GetWeatherByCity, which names the
+// nearest place, rather than One Call.
+func (a *OpenWeatherAdapter) GetWeatherByCoordinates(ctx context.Context, lat float32, lon float32, lang language.Code) (*entity.Weather, error) {
+	return circuitbreaker.Execute(ctx, a.breakers[openWeatherAPIWeather], func(ctx context.Context) (*entity.Weather, error) {
+		return a.fetchWeatherData(ctx, fmt.Sprintf("lat=%g&lon=%g", lat, lon), fmt.Sprintf("no weather found for lat %g, lon %g", lat, lon), lang)
+	})
+}
+
+func (a *OpenWeatherAdapter) GetWeatherOverviewByLatLong(ctx context.Context, lon float32, lat float32, system units.System) (*entity.WeatherOverview, error) {
+	return circuitbreaker.Execute(ctx, a.breakers[openWeatherAPIOneCallOverview], func(ctx context.Context) (*entity.WeatherOverview, error) {
+		return a.fetchWeatherOverviewData(ctx, lon, lat, system)
+	})
+}
 
-	result, err := a.circuitBreaker.Execute(ctx, func() (interface{}, error) {
-		return a.fetchWeatherData(city)
+func (a *OpenWeatherAdapter) GetForecastByCity(ctx context.Context, city string, lang language.Code) (*entity.Forecast, error) {
+	return circuitbreaker.Execute(ctx, a.breakers[openWeatherAPIForecast], func(ctx context.Context) (*entity.Forecast, error) {
+		return a.fetchForecastData(ctx, city, lang)
 	})
+}
 
+// getJSON performs a GET with retry and decodes a successful JSON body into out.
+// A 404 is reported as support.ErrNotFound, preferring the upstream message over notFoundMsg; other
+// failed answers are mapped by statusError.
+func (a *OpenWeatherAdapter) getJSON(ctx context.Context, endpoint string, notFoundMsg string, out interface{}) error {
+	resp, err := a.doGetWithRetry(ctx, endpoint)
 	if err != nil {
-		return nil, err // Pass the error up, including custom error types
+		return err
 	}
+	defer func() { _ = resp.Body.Close() }()
 
-	weather, ok := result.(*entity.Weather)
-	if !ok {
-		return nil, fmt.Errorf("unexpected result type from circuit breaker")
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response body: %w", err)
 	}
 
-	return weather, nil
-}
-
-func (a *OpenWeatherAdapter) GetWeatherOverviewByLatLong(lon float32, lat float32) (*entity.WeatherOverview, error) {
-	ctx := context.Background()
+	if resp.StatusCode != http.StatusOK {
+		var apiResp OpenWeatherResponse
+		_ = json.Unmarshal(body, &apiResp)
 
-	result, err := a.circuitBreaker.Execute(ctx, func() (interface{}, error) {
-		return a.fetchWeatherOverviewData(lon, lat)
-	})
+		if resp.StatusCode == http.StatusNotFound {
+			msg := notFoundMsg
+			if apiResp.Message != "" {
+				msg = apiResp.Message
+			}
+			return support.NewErrNotFound(msg)
+		}
 
-	if err != nil {
-		return nil, err // Pass the error up, including custom error types
+		return statusError(resp, body, apiResp.Message)
 	}
 
-	weatherOverview, ok := result.(*entity.WeatherOverview)
-	if !ok {
-		return nil, fmt.Errorf("unexpected result type from circuit breaker")
+	if err := json.Unmarshal(body, out); err != nil {
+		return fmt.Errorf("failed to decode successful response: %w", err)
 	}
 
-	return weatherOverview, nil
+	return nil
 }
 
-// fetchWeatherData makes the actual HTTP request to OpenWeather API
-func (a *OpenWeatherAdapter) fetchWeatherData(city string) (*entity.Weather, error) {
-	url := fmt.Sprintf("%s/data/2.5/weather?q=%s&appid=%s&units=metric", a.baseURL, city, a.apiKey)
+// fetchWeatherData makes the actual HTTP request to OpenWeather API; location is the escaped query
+// naming a city or coordinates, and notFoundMsg is used when the upstream 404 carries no message.
+func (a *OpenWeatherAdapter) fetchWeatherData(ctx context.Context, location string, notFoundMsg string, lang language.Code) (*entity.Weather, error) {
+	endpoint := fmt.Sprintf("%s/data/2.5/weather?%s&appid=%s&units=metric&lang=%s", a.baseURL, location, a.apiKey, lang.OrDefault())
 
-	resp, err := a.doGetWithRetry(url)
+	resp, err := a.doGetWithRetry(ctx, endpoint)
 	if err != nil {
-		return nil, fmt.Errorf("HTTP request failed: %w", err)
+		return nil, err
 	}
 	defer func() { _ = resp.Body.Close() }() // Properly handle close error
 
@@ -174,15 +252,15 @@ func (a *OpenWeatherAdapter) fetchWeatherData(city string) (*entity.Weather, err
 
 		// If the API returns a 404, we return our custom ErrNotFound
 		if resp.StatusCode == http.StatusNotFound {
-			msg := fmt.Sprintf("city '%s' not found", city)
+			msg := notFoundMsg
 			if apiResp.Message != "" {
 				msg = apiResp.Message // Use the more specific message from the API if available
 			}
 			return nil, support.NewErrNotFound(msg)
 		}
 
-		// For all other errors, return a generic error
-		return nil, fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))
+		// For all other errors, return a typed upstream or bad request error
+		return nil, statusError(resp, body, apiResp.Message)
 	}
 
 	var apiResp OpenWeatherResponse
@@ -202,18 +280,21 @@ func (a *OpenWeatherAdapter) fetchWeatherData(city string) (*entity.Weather, err
 		Humidity:    apiResp.Main.Humidity,
 		WindSpeed:   apiResp.Wind.Speed,
 		Timestamp:   time.Now(),
+		Provider:    config.ProviderOpenWeather,
+		Units:       units.Metric,
+		Language:    lang.OrDefault(),
 	}
 
 	return weather, nil
 }
 
 // fetchWeatherData makes the actual HTTP request to OpenWeather API
-func (a *OpenWeatherAdapter) fetchWeatherOverviewData(lon float32, lat float32) (*entity.WeatherOverview, error) {
-	url := fmt.Sprintf("%s/data/3.0/onecall/overview?appid=%s&lat=%f&lon=%f", a.baseURL, a.apiKey, lon, lat)
+func (a *OpenWeatherAdapter) fetchWeatherOverviewData(ctx context.Context, lon float32, lat float32, system units.System) (*entity.WeatherOverview, error) {
+	url := fmt.Sprintf("%s/data/3.0/onecall/overview?appid=%s&lat=%f&lon=%f&units=%s", a.baseURL, a.apiKey, lon, lat, system.OrMetric())
 
-	resp, err := a.doGetWithRetry(url)
+	resp, err := a.doGetWithRetry(ctx, url)
 	if err != nil {
-		return nil, fmt.Errorf("HTTP request failed: %w", err)
+		return nil, err
 	}
 	defer func() { _ = resp.Body.Close() }() // Properly handle close error
 
@@ -237,8 +318,8 @@ func (a *OpenWeatherAdapter) fetchWeatherOverviewData(lon float32, lat float32)
 			return nil, support.NewErrNotFound(msg)
 		}
 
-		// For all other errors, return a generic error
-		return nil, fmt.Errorf("fetchWeatherOverviewData API request failed with status %d: %s", resp.StatusCode, string(body))
+		// For all other errors, return a typed upstream or bad request error
+		return nil, statusError(resp, body, apiResp.Message)
 	}
 
 	var apiResp OpenWeatherOverviewResponse
@@ -253,9 +334,52 @@ func (a *OpenWeatherAdapter) fetchWeatherOverviewData(lon float32, lat float32)
 		Date:            apiResp.Date,
 		Units:           apiResp.Units,
 		WeatherOverview: apiResp.WeatherOverview,
+		Provider:        config.ProviderOpenWeather,
 	}
 
 	return weatherOverview, nil
 }
 
+// fetchForecastData requests the 5 day / 3 hour forecast for a city.
+func (a *OpenWeatherAdapter) fetchForecastData(ctx context.Context, city string, lang language.Code) (*entity.Forecast, error) {
+	endpoint := fmt.Sprintf("%s/data/2.5/forecast?q=%s&appid=%s&units=metric&lang=%s", a.baseURL, url.QueryEscape(city), a.apiKey, lang.OrDefault())
+
+	var apiResp OpenWeatherForecastResponse
+	if err := a.getJSON(ctx, endpoint, fmt.Sprintf("city '%s' not found", city), &apiResp); err != nil {
+		return nil, err
+	}
+
+	slots := make([]entity.ForecastSlot, 0, len(apiResp.List))
+	for _, item := range apiResp.List {
+		description := ""
+		if len(item.Weather) > 0 {
+			description = item.Weather[0].Description
+		}
+		slots = append(slots, entity.ForecastSlot{
+			Time:                     time.Unix(item.Dt, 0).UTC(),
+			Temperature:              item.Main.Temp,
+			FeelsLike:                item.Main.FeelsLike,
+			TempMin:                  item.Main.TempMin,
+			TempMax:                  item.Main.TempMax,
+			Description:              description,
+			Humidity:                 item.Main.Humidity,
+			WindSpeed:                item.Wind.Speed,
+			PrecipitationProbability: item.Pop,
+		})
+	}
+
+	forecast := &entity.Forecast{
+		City:     apiResp.City.Name,
+		Country:  apiResp.City.Country,
+		Lat:      apiResp.City.Coord.Lat,
+		Lon:      apiResp.City.Coord.Lon,
+		Slots:    slots,
+		Provider: config.ProviderOpenWeather,
+		Units:    units.Metric,
+		Language: lang.OrDefault(),
+	}
+
+	return forecast, nil
+}
+
 var _ repository.WeatherRepository = (*OpenWeatherAdapter)(nil)
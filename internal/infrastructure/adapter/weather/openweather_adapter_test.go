@@ -1,13 +1,19 @@
 package weather
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"sync/atomic"
 	"testing"
 	"time"
 
+	"weather-api/internal/core/domain/entity"
+	"weather-api/internal/infrastructure/support"
 	"weather-api/pkg/circuitbreaker"
+	"weather-api/pkg/language"
+	"weather-api/pkg/units"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -54,14 +60,14 @@ func TestOpenWeatherAdapter_GetWeatherByCity_Success(t *testing.T) {
 		client:         &http.Client{Timeout: 10 * time.Second},
 		apiKey:         "test-api-key",
 		baseURL:        mockServer.URL,
-		circuitBreaker: circuitbreaker.NewCircuitBreaker("test-openweather-api"),
+		breakers:       newOpenWeatherBreakers(circuitbreaker.DefaultSettings(), nil),
 		maxAttempts:    1,
 		initialBackoff: 50 * time.Millisecond,
 		maxBackoff:     100 * time.Millisecond,
 	}
 
 	// Act
-	weather, err := adapter.GetWeatherByCity("Istanbul")
+	weather, err := adapter.GetWeatherByCity(context.Background(), "Istanbul", language.English)
 
 	// Assert
 	assert.NoError(t, err)
@@ -73,6 +79,40 @@ func TestOpenWeatherAdapter_GetWeatherByCity_Success(t *testing.T) {
 	assert.Equal(t, 10.5, weather.WindSpeed)
 }
 
+func TestOpenWeatherAdapter_GetWeatherByCoordinates(t *testing.T) {
+	// Arrange
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/data/2.5/weather", r.URL.Path, "coordinates use the current weather API, not One Call")
+		assert.Equal(t, "0", r.URL.Query().Get("lat"))
+		assert.Equal(t, "-0.12", r.URL.Query().Get("lon"))
+		assert.Equal(t, "de", r.URL.Query().Get("lang"))
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"main": {"temp": 21.5, "humidity": 80}, "weather": [{"description": "Klarer Himmel"}], "wind": {"speed": 4.1}, "name": "Null Island"}`))
+	}))
+	defer mockServer.Close()
+
+	adapter := &OpenWeatherAdapter{
+		client:   &http.Client{Timeout: 10 * time.Second},
+		apiKey:   "test-api-key",
+		baseURL:  mockServer.URL,
+		breakers: newOpenWeatherBreakers(circuitbreaker.DefaultSettings(), nil),
+	}
+
+	// Act
+	weather, err := adapter.GetWeatherByCoordinates(context.Background(), 0, -0.12, "de")
+
+	// Assert
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, "Null Island", weather.City)
+	assert.Equal(t, 21.5, weather.Temperature)
+	assert.Equal(t, "Klarer Himmel", weather.Description)
+	assert.Equal(t, language.Code("de"), weather.Language)
+	assert.Equal(t, uint32(1), adapter.breakers[openWeatherAPIWeather].Snapshot().Requests)
+}
+
 func TestOpenWeatherAdapter_GetWeatherByCity_NotFound(t *testing.T) {
 	// Arrange - Create mock server
 	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -84,14 +124,14 @@ func TestOpenWeatherAdapter_GetWeatherByCity_NotFound(t *testing.T) {
 
 	// Create adapter with mock server URL
 	adapter := &OpenWeatherAdapter{
-		client:         &http.Client{Timeout: 10 * time.Second},
-		apiKey:         "test-api-key",
-		baseURL:        mockServer.URL,
-		circuitBreaker: circuitbreaker.NewCircuitBreaker("test-openweather-api"),
+		client:   &http.Client{Timeout: 10 * time.Second},
+		apiKey:   "test-api-key",
+		baseURL:  mockServer.URL,
+		breakers: newOpenWeatherBreakers(circuitbreaker.DefaultSettings(), nil),
 	}
 
 	// Act
-	weather, err := adapter.GetWeatherByCity("InvalidCity")
+	weather, err := adapter.GetWeatherByCity(context.Background(), "InvalidCity", language.English)
 
 	// Assert
 	assert.Error(t, err)
@@ -99,6 +139,32 @@ func TestOpenWeatherAdapter_GetWeatherByCity_NotFound(t *testing.T) {
 	assert.Contains(t, err.Error(), "not found")
 }
 
+func TestOpenWeatherAdapter_GetWeatherByCity_EscapesCity(t *testing.T) {
+	// Arrange
+	var gotCity, gotAppID string
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotCity = r.URL.Query().Get("q")
+		gotAppID = r.URL.Query().Get("appid")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"cod":"404","message":"city not found"}`))
+	}))
+	defer mockServer.Close()
+
+	adapter := &OpenWeatherAdapter{
+		client:   &http.Client{Timeout: 10 * time.Second},
+		apiKey:   "test-api-key",
+		baseURL:  mockServer.URL,
+		breakers: newOpenWeatherBreakers(circuitbreaker.DefaultSettings(), nil),
+	}
+
+	// Act
+	_, _ = adapter.GetWeatherByCity(context.Background(), "São Paulo&appid=other", language.English)
+
+	// Assert
+	assert.Equal(t, "São Paulo&appid=other", gotCity)
+	assert.Equal(t, "test-api-key", gotAppID)
+}
+
 func TestOpenWeatherAdapter_GetWeatherByCity_InvalidResponse(t *testing.T) {
 	// Arrange - Create mock server
 	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -111,14 +177,14 @@ func TestOpenWeatherAdapter_GetWeatherByCity_InvalidResponse(t *testing.T) {
 
 	// Create adapter with mock server URL
 	adapter := &OpenWeatherAdapter{
-		client:         &http.Client{Timeout: 10 * time.Second},
-		apiKey:         "test-api-key",
-		baseURL:        mockServer.URL,
-		circuitBreaker: circuitbreaker.NewCircuitBreaker("test-openweather-api"),
+		client:   &http.Client{Timeout: 10 * time.Second},
+		apiKey:   "test-api-key",
+		baseURL:  mockServer.URL,
+		breakers: newOpenWeatherBreakers(circuitbreaker.DefaultSettings(), nil),
 	}
 
 	// Act
-	weather, err := adapter.GetWeatherByCity("Istanbul")
+	weather, err := adapter.GetWeatherByCity(context.Background(), "Istanbul", language.English)
 
 	// Assert
 	assert.Error(t, err)
@@ -138,20 +204,22 @@ func TestOpenWeatherAdapter_GetWeatherByCity_Timeout(t *testing.T) {
 
 	// Create adapter with short timeout
 	adapter := &OpenWeatherAdapter{
-		client:         &http.Client{Timeout: 1 * time.Second},
-		apiKey:         "test-api-key",
-		baseURL:        mockServer.URL,
-		circuitBreaker: circuitbreaker.NewCircuitBreaker("test-openweather-api"),
-		maxAttempts:    1,
+		client:      &http.Client{Timeout: 1 * time.Second},
+		apiKey:      "test-api-key",
+		baseURL:     mockServer.URL,
+		breakers:    newOpenWeatherBreakers(circuitbreaker.DefaultSettings(), nil),
+		maxAttempts: 1,
 	}
 
 	// Act
-	weather, err := adapter.GetWeatherByCity("Istanbul")
+	weather, err := adapter.GetWeatherByCity(context.Background(), "Istanbul", language.English)
 
 	// Assert
 	assert.Error(t, err)
 	assert.Nil(t, weather)
 	assert.Contains(t, err.Error(), "timeout")
+	assert.IsType(t, &support.ErrTimeout{}, err)
+	assert.NotContains(t, err.Error(), "test-api-key")
 }
 
 func TestOpenWeatherAdapter_GetWeatherByCity_EmptyWeatherArray(t *testing.T) {
@@ -185,14 +253,14 @@ func TestOpenWeatherAdapter_GetWeatherByCity_EmptyWeatherArray(t *testing.T) {
 
 	// Create adapter with mock server URL
 	adapter := &OpenWeatherAdapter{
-		client:         &http.Client{Timeout: 10 * time.Second},
-		apiKey:         "test-api-key",
-		baseURL:        mockServer.URL,
-		circuitBreaker: circuitbreaker.NewCircuitBreaker("test-openweather-api"),
+		client:   &http.Client{Timeout: 10 * time.Second},
+		apiKey:   "test-api-key",
+		baseURL:  mockServer.URL,
+		breakers: newOpenWeatherBreakers(circuitbreaker.DefaultSettings(), nil),
 	}
 
 	// Act
-	weather, err := adapter.GetWeatherByCity("Istanbul")
+	weather, err := adapter.GetWeatherByCity(context.Background(), "Istanbul", language.English)
 
 	// Assert
 	assert.NoError(t, err)
@@ -201,3 +269,634 @@ func TestOpenWeatherAdapter_GetWeatherByCity_EmptyWeatherArray(t *testing.T) {
 	assert.Equal(t, "", weather.Description) // Should be empty string
 	assert.Equal(t, 25.5, weather.Temperature)
 }
+
+func TestOpenWeatherAdapter_GetWeatherByCity_Localized(t *testing.T) {
+	// Arrange - Create mock server
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "de", r.URL.Query().Get("lang"))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"name": "Berlin", "main": {"temp": 4.2, "humidity": 90}, "weather": [{"description": "leichter Regen"}], "wind": {"speed": 3.1}}`))
+	}))
+	defer mockServer.Close()
+
+	adapter := &OpenWeatherAdapter{
+		client:      &http.Client{Timeout: 10 * time.Second},
+		apiKey:      "test-api-key",
+		baseURL:     mockServer.URL,
+		breakers:    newOpenWeatherBreakers(circuitbreaker.DefaultSettings(), nil),
+		maxAttempts: 1,
+	}
+
+	// Act
+	weather, err := adapter.GetWeatherByCity(context.Background(), "Berlin", "de")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "leichter Regen", weather.Description)
+	assert.Equal(t, language.Code("de"), weather.Language)
+}
+
+func TestOpenWeatherAdapter_GetForecastByCity_Success(t *testing.T) {
+	// Arrange - Create mock server
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/data/2.5/forecast", r.URL.Path)
+		assert.Equal(t, "London", r.URL.Query().Get("q"))
+		assert.Equal(t, "metric", r.URL.Query().Get("units"))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{
+			"list": [
+				{"dt": 1705320000, "main": {"temp": 7.5, "feels_like": 5.1, "temp_min": 7.0, "temp_max": 8.0, "humidity": 81},
+				 "weather": [{"description": "light rain"}], "wind": {"speed": 4.2}, "pop": 0.4},
+				{"dt": 1705330800, "main": {"temp": 6.9, "humidity": 85}, "weather": [], "wind": {"speed": 3.8}, "pop": 0}
+			],
+			"city": {"name": "London", "country": "GB", "coord": {"lat": 51.5085, "lon": -0.1257}}
+		}`))
+	}))
+	defer mockServer.Close()
+
+	adapter := &OpenWeatherAdapter{
+		client:      &http.Client{Timeout: 10 * time.Second},
+		apiKey:      "test-api-key",
+		baseURL:     mockServer.URL,
+		breakers:    newOpenWeatherBreakers(circuitbreaker.DefaultSettings(), nil),
+		maxAttempts: 1,
+	}
+
+	// Act
+	forecast, err := adapter.GetForecastByCity(context.Background(), "London", language.English)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.NotNil(t, forecast)
+	assert.Equal(t, "London", forecast.City)
+	assert.Equal(t, "GB", forecast.Country)
+	assert.Len(t, forecast.Slots, 2)
+	assert.Equal(t, time.Unix(1705320000, 0).UTC(), forecast.Slots[0].Time)
+	assert.Equal(t, "light rain", forecast.Slots[0].Description)
+	assert.Equal(t, 0.4, forecast.Slots[0].PrecipitationProbability)
+	assert.Equal(t, "", forecast.Slots[1].Description)
+}
+
+func TestOpenWeatherAdapter_GetForecastByCity_NotFound(t *testing.T) {
+	// Arrange - Create mock server
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"cod":"404","message":"city not found"}`))
+	}))
+	defer mockServer.Close()
+
+	adapter := &OpenWeatherAdapter{
+		client:   &http.Client{Timeout: 10 * time.Second},
+		apiKey:   "test-api-key",
+		baseURL:  mockServer.URL,
+		breakers: newOpenWeatherBreakers(circuitbreaker.DefaultSettings(), nil),
+	}
+
+	// Act
+	forecast, err := adapter.GetForecastByCity(context.Background(), "Nowhere", language.English)
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, forecast)
+	assert.IsType(t, &support.ErrNotFound{}, err)
+}
+
+func TestOpenWeatherAdapter_GetOneCall_ExcludesUnrequestedBlocks(t *testing.T) {
+	// Arrange - Create mock server
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/data/3.0/onecall", r.URL.Path)
+		assert.Equal(t, "current,minutely,alerts", r.URL.Query().Get("exclude"))
+		assert.Equal(t, "metric", r.URL.Query().Get("units"))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{
+			"lat": 51.5, "lon": -0.12, "timezone": "Europe/London", "timezone_offset": 0,
+			"hourly": [{"dt": 1705320000, "temp": 7.5, "humidity": 81, "weather": [{"description": "light rain"}], "pop": 0.4}],
+			"daily": [{"dt": 1705320000, "summary": "Rainy day", "temp": {"min": 4.1, "max": 9.2}, "weather": [{"description": "rain"}]}]
+		}`))
+	}))
+	defer mockServer.Close()
+
+	adapter := &OpenWeatherAdapter{
+		client:      &http.Client{Timeout: 10 * time.Second},
+		apiKey:      "test-api-key",
+		baseURL:     mockServer.URL,
+		breakers:    newOpenWeatherBreakers(circuitbreaker.DefaultSettings(), nil),
+		maxAttempts: 1,
+	}
+
+	// Act
+	oneCall, err := adapter.GetOneCall(context.Background(), 51.5, -0.12, []entity.OneCallBlock{entity.OneCallHourly, entity.OneCallDaily}, language.English)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "Europe/London", oneCall.Timezone)
+	assert.Nil(t, oneCall.Current)
+	assert.Len(t, oneCall.Hourly, 1)
+	assert.Equal(t, "light rain", oneCall.Hourly[0].Description)
+	assert.Len(t, oneCall.Daily, 1)
+	assert.Equal(t, 9.2, oneCall.Daily[0].TempMax)
+	assert.Equal(t, "Rainy day", oneCall.Daily[0].Summary)
+}
+
+func TestOpenWeatherAdapter_GetOneCall_AllBlocks(t *testing.T) {
+	// Arrange - Create mock server
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Empty(t, r.URL.Query().Get("exclude"))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{
+			"current": {"dt": 1705320000, "temp": 7.5, "weather": [{"description": "overcast clouds"}]},
+			"minutely": [{"dt": 1705320060, "precipitation": 0.2}],
+			"alerts": [{"sender_name": "Met Office", "event": "Yellow wind warning", "start": 1705320000, "end": 1705406400, "tags": ["Wind"]}]
+		}`))
+	}))
+	defer mockServer.Close()
+
+	adapter := &OpenWeatherAdapter{
+		client:      &http.Client{Timeout: 10 * time.Second},
+		apiKey:      "test-api-key",
+		baseURL:     mockServer.URL,
+		breakers:    newOpenWeatherBreakers(circuitbreaker.DefaultSettings(), nil),
+		maxAttempts: 1,
+	}
+
+	// Act
+	oneCall, err := adapter.GetOneCall(context.Background(), 51.5, -0.12, nil, language.English)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.NotNil(t, oneCall.Current)
+	assert.Equal(t, "overcast clouds", oneCall.Current.Description)
+	assert.Len(t, oneCall.Minutely, 1)
+	assert.Len(t, oneCall.Alerts, 1)
+	assert.Equal(t, "Yellow wind warning", oneCall.Alerts[0].Event)
+	assert.Equal(t, []string{"Wind"}, oneCall.Alerts[0].Tags)
+}
+
+func TestOpenWeatherAdapter_GetAirQualityHistory_Success(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(2 * time.Hour)
+
+	// Arrange - Create mock server
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/data/2.5/air_pollution/history", r.URL.Path)
+		assert.Equal(t, "1704067200", r.URL.Query().Get("start"))
+		assert.Equal(t, "1704074400", r.URL.Query().Get("end"))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{
+			"coord": {"lat": 51.5, "lon": -0.12},
+			"list": [
+				{"dt": 1704067200, "main": {"aqi": 2}, "components": {"co": 230.3, "no2": 18.2, "o3": 40.1, "so2": 2.1, "pm2_5": 9.4, "pm10": 12.7}},
+				{"dt": 1704070800, "main": {"aqi": 3}, "components": {"pm2_5": 22.0}}
+			]
+		}`))
+	}))
+	defer mockServer.Close()
+
+	adapter := &OpenWeatherAdapter{
+		client:      &http.Client{Timeout: 10 * time.Second},
+		apiKey:      "test-api-key",
+		baseURL:     mockServer.URL,
+		breakers:    newOpenWeatherBreakers(circuitbreaker.DefaultSettings(), nil),
+		maxAttempts: 1,
+	}
+
+	// Act
+	airQuality, err := adapter.GetAirQualityHistory(context.Background(), 51.5, -0.12, start, end)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, airQuality.Readings, 2)
+	assert.Equal(t, 2, airQuality.Readings[0].AQI)
+	assert.Equal(t, 9.4, airQuality.Readings[0].Components.PM2_5)
+	assert.Equal(t, 230.3, airQuality.Readings[0].Components.CO)
+	assert.Equal(t, start, airQuality.Readings[0].Time)
+}
+
+func TestOpenWeatherAdapter_GetAirQuality_UpstreamError(t *testing.T) {
+	// Arrange - Create mock server
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/data/2.5/air_pollution", r.URL.Path)
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"cod":401,"message":"Invalid API key"}`))
+	}))
+	defer mockServer.Close()
+
+	adapter := &OpenWeatherAdapter{
+		client:      &http.Client{Timeout: 10 * time.Second},
+		apiKey:      "bad-key",
+		baseURL:     mockServer.URL,
+		breakers:    newOpenWeatherBreakers(circuitbreaker.DefaultSettings(), nil),
+		maxAttempts: 1,
+	}
+
+	// Act
+	airQuality, err := adapter.GetAirQuality(context.Background(), 51.5, -0.12)
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, airQuality)
+	assert.IsType(t, &support.ErrUpstreamUnauthorized{}, err)
+	assert.Contains(t, err.Error(), "401")
+}
+
+func TestOpenWeatherAdapter_SearchLocations_Success(t *testing.T) {
+	// Arrange - Create mock server
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/geo/1.0/direct", r.URL.Path)
+		assert.Equal(t, "Paris,TX,US", r.URL.Query().Get("q"))
+		assert.Equal(t, "5", r.URL.Query().Get("limit"))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`[{"name": "Paris", "local_names": {"en": "Paris", "ru": "Пэрис"}, "lat": 33.66, "lon": -95.55, "country": "US", "state": "Texas"}]`))
+	}))
+	defer mockServer.Close()
+
+	adapter := &OpenWeatherAdapter{
+		client:      &http.Client{Timeout: 10 * time.Second},
+		apiKey:      "test-api-key",
+		baseURL:     mockServer.URL,
+		breakers:    newOpenWeatherBreakers(circuitbreaker.DefaultSettings(), nil),
+		maxAttempts: 1,
+	}
+
+	// Act
+	locations, err := adapter.SearchLocations(context.Background(), "Paris,TX,US", 5)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, locations, 1)
+	assert.Equal(t, "Texas", locations[0].State)
+	assert.Equal(t, "Пэрис", locations[0].LocalNames["ru"])
+	assert.Equal(t, float32(33.66), locations[0].Lat)
+}
+
+func TestOpenWeatherAdapter_ReverseGeocode_Empty(t *testing.T) {
+	// Arrange - Create mock server
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/geo/1.0/reverse", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`[]`))
+	}))
+	defer mockServer.Close()
+
+	adapter := &OpenWeatherAdapter{
+		client:      &http.Client{Timeout: 10 * time.Second},
+		apiKey:      "test-api-key",
+		baseURL:     mockServer.URL,
+		breakers:    newOpenWeatherBreakers(circuitbreaker.DefaultSettings(), nil),
+		maxAttempts: 1,
+	}
+
+	// Act
+	locations, err := adapter.ReverseGeocode(context.Background(), 0, 0, 1)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.NotNil(t, locations)
+	assert.Empty(t, locations)
+}
+
+func TestOpenWeatherAdapter_GetHistoricalWeather_Success(t *testing.T) {
+	at := time.Date(2024, 1, 9, 14, 0, 0, 0, time.UTC)
+
+	// Arrange - Create mock server
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/data/3.0/onecall/timemachine", r.URL.Path)
+		assert.Equal(t, "1704808800", r.URL.Query().Get("dt"))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"lat": 38.4, "lon": 27.1, "timezone": "Europe/Istanbul",
+			"data": [{"dt": 1704808800, "temp": 12.4, "humidity": 76, "wind_speed": 5.1, "weather": [{"description": "broken clouds"}]}]}`))
+	}))
+	defer mockServer.Close()
+
+	adapter := &OpenWeatherAdapter{
+		client:      &http.Client{Timeout: 10 * time.Second},
+		apiKey:      "test-api-key",
+		baseURL:     mockServer.URL,
+		breakers:    newOpenWeatherBreakers(circuitbreaker.DefaultSettings(), nil),
+		maxAttempts: 1,
+	}
+
+	// Act
+	historical, err := adapter.GetHistoricalWeather(context.Background(), 38.4, 27.1, at, language.English)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "Europe/Istanbul", historical.Timezone)
+	assert.Len(t, historical.Observations, 1)
+	assert.Equal(t, at, historical.Observations[0].Time)
+	assert.Equal(t, "broken clouds", historical.Observations[0].Description)
+}
+
+func TestOpenWeatherAdapter_GetDaySummary_Success(t *testing.T) {
+	// Arrange - Create mock server
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/data/3.0/onecall/day_summary", r.URL.Path)
+		assert.Equal(t, "2024-01-09", r.URL.Query().Get("date"))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"lat": 38.4, "lon": 27.1, "date": "2024-01-09",
+			"temperature": {"min": 4.2, "max": 11.8, "afternoon": 11.2},
+			"precipitation": {"total": 1.6},
+			"wind": {"max": {"speed": 8.7, "direction": 250}}}`))
+	}))
+	defer mockServer.Close()
+
+	adapter := &OpenWeatherAdapter{
+		client:      &http.Client{Timeout: 10 * time.Second},
+		apiKey:      "test-api-key",
+		baseURL:     mockServer.URL,
+		breakers:    newOpenWeatherBreakers(circuitbreaker.DefaultSettings(), nil),
+		maxAttempts: 1,
+	}
+
+	// Act
+	summary, err := adapter.GetDaySummary(context.Background(), 38.4, 27.1, time.Date(2024, 1, 9, 18, 0, 0, 0, time.UTC))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 1, 9, 0, 0, 0, 0, time.UTC), summary.Date)
+	assert.Equal(t, 4.2, summary.TempMin)
+	assert.Equal(t, 11.8, summary.TempMax)
+	assert.Equal(t, 1.6, summary.Precipitation)
+	assert.Equal(t, 8.7, summary.WindMaxSpeed)
+}
+
+func TestOpenWeatherAdapter_GetWeatherByCity_ServerErrorIsUpstream(t *testing.T) {
+	// Arrange
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+		w.Write([]byte(`{"message":"bad gateway"}`))
+	}))
+	defer mockServer.Close()
+
+	adapter := &OpenWeatherAdapter{
+		client:      &http.Client{Timeout: 10 * time.Second},
+		apiKey:      "test-api-key",
+		baseURL:     mockServer.URL,
+		breakers:    newOpenWeatherBreakers(circuitbreaker.DefaultSettings(), nil),
+		maxAttempts: 1,
+	}
+
+	// Act
+	weather, err := adapter.GetWeatherByCity(context.Background(), "Istanbul", language.English)
+
+	// Assert
+	assert.Nil(t, weather)
+	var upstreamErr *support.ErrUpstream
+	if assert.ErrorAs(t, err, &upstreamErr) {
+		assert.Equal(t, http.StatusBadGateway, upstreamErr.StatusCode)
+	}
+}
+
+func TestOpenWeatherAdapter_GetWeatherByCity_CancelStopsRetryBackoff(t *testing.T) {
+	// Arrange
+	ctx, cancel := context.WithCancel(context.Background())
+	var requests atomic.Int32
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+		cancel()
+	}))
+	defer mockServer.Close()
+
+	adapter := &OpenWeatherAdapter{
+		client:         &http.Client{Timeout: 10 * time.Second},
+		apiKey:         "test-api-key",
+		baseURL:        mockServer.URL,
+		breakers:       newOpenWeatherBreakers(circuitbreaker.DefaultSettings(), nil),
+		maxAttempts:    3,
+		initialBackoff: 10 * time.Second,
+		maxBackoff:     10 * time.Second,
+	}
+
+	// Act
+	started := time.Now()
+	weather, err := adapter.GetWeatherByCity(ctx, "Istanbul", language.English)
+
+	// Assert
+	assert.Nil(t, weather)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Less(t, time.Since(started), 5*time.Second)
+	assert.Equal(t, int32(1), requests.Load())
+}
+
+func TestOpenWeatherAdapter_GetWeatherByCity_CanceledContextSkipsRequest(t *testing.T) {
+	// Arrange
+	var requests atomic.Int32
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+	}))
+	defer mockServer.Close()
+
+	adapter := &OpenWeatherAdapter{
+		client:      &http.Client{Timeout: 10 * time.Second},
+		apiKey:      "test-api-key",
+		baseURL:     mockServer.URL,
+		breakers:    newOpenWeatherBreakers(circuitbreaker.DefaultSettings(), nil),
+		maxAttempts: 1,
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// Act
+	weather, err := adapter.GetWeatherByCity(ctx, "Istanbul", language.English)
+
+	// Assert
+	assert.Nil(t, weather)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, int32(0), requests.Load())
+}
+
+func TestOpenWeatherAdapter_NotFoundDoesNotTripBreaker(t *testing.T) {
+	// Arrange
+	var requests atomic.Int32
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"cod":"404","message":"city not found"}`))
+	}))
+	defer mockServer.Close()
+
+	adapter := &OpenWeatherAdapter{
+		client:   &http.Client{Timeout: 10 * time.Second},
+		apiKey:   "test-api-key",
+		baseURL:  mockServer.URL,
+		breakers: newOpenWeatherBreakers(circuitbreaker.Settings{MaxRequests: 1, Timeout: time.Minute, MinRequests: 1, FailureRatio: 0.5}, nil),
+	}
+
+	// Act
+	var errs []error
+	for i := 0; i < 5; i++ {
+		_, err := adapter.GetWeatherByCity(context.Background(), "Atlantis", language.English)
+		errs = append(errs, err)
+	}
+
+	// Assert
+	for _, err := range errs {
+		assert.IsType(t, &support.ErrNotFound{}, err)
+	}
+	assert.Equal(t, int32(5), requests.Load(), "every lookup reaches the upstream")
+	assert.Equal(t, circuitbreaker.StateClosed, adapter.breakers[openWeatherAPIWeather].State())
+}
+
+func TestOpenWeatherAdapter_BadRequestDoesNotTripBreaker(t *testing.T) {
+	// Arrange
+	var requests atomic.Int32
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"cod":"400","message":"wrong latitude"}`))
+	}))
+	defer mockServer.Close()
+
+	adapter := &OpenWeatherAdapter{
+		client:   &http.Client{Timeout: 10 * time.Second},
+		apiKey:   "test-api-key",
+		baseURL:  mockServer.URL,
+		breakers: newOpenWeatherBreakers(circuitbreaker.Settings{MaxRequests: 1, Timeout: time.Minute, MinRequests: 1, FailureRatio: 0.5}, nil),
+	}
+
+	// Act
+	var errs []error
+	for i := 0; i < 5; i++ {
+		_, err := adapter.GetWeatherOverviewByLatLong(context.Background(), 10, 100, units.Metric)
+		errs = append(errs, err)
+	}
+
+	// Assert
+	for _, err := range errs {
+		assert.Equal(t, support.NewErrBadRequest("wrong latitude"), err)
+	}
+	assert.Equal(t, int32(5), requests.Load(), "every lookup reaches the upstream")
+	assert.Equal(t, circuitbreaker.StateClosed, adapter.breakers[openWeatherAPIOneCall].State())
+}
+
+func TestOpenWeatherAdapter_StatusErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		header  string
+		body    string
+		wantErr error
+	}{
+		{"bad request without reason", http.StatusBadRequest, "", `{}`, support.NewErrBadRequest("upstream rejected the request as invalid")},
+		{"rejected key", http.StatusUnauthorized, "", `{"cod":401,"message":"Invalid API key."}`, support.NewErrUpstreamUnauthorized("upstream rejected the API key (401 Unauthorized)")},
+		{"throttled", http.StatusTooManyRequests, "30", `{"cod":429}`, support.NewErrUpstreamRateLimited("upstream rate limit exceeded (429 Too Many Requests)", 30*time.Second)},
+		{"server error", http.StatusBadGateway, "", `oops`, support.NewErrUpstream(http.StatusBadGateway, "oops")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if tt.header != "" {
+					w.Header().Set("Retry-After", tt.header)
+				}
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer mockServer.Close()
+
+			adapter := &OpenWeatherAdapter{
+				client:      &http.Client{Timeout: 10 * time.Second},
+				apiKey:      "test-api-key",
+				baseURL:     mockServer.URL,
+				maxAttempts: 1,
+				breakers:    newOpenWeatherBreakers(circuitbreaker.DefaultSettings(), nil),
+			}
+
+			// Act
+			_, err := adapter.GetWeatherByCity(context.Background(), "London", language.English)
+
+			// Assert
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestOpenWeatherAdapter_OneCallOutageKeepsWeatherAvailable(t *testing.T) {
+	// Arrange
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/data/3.0/onecall/overview" {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"name":"London","main":{"temp":10,"humidity":80},"weather":[{"description":"rain"}]}`))
+	}))
+	defer mockServer.Close()
+
+	adapter := &OpenWeatherAdapter{
+		client:      &http.Client{Timeout: 10 * time.Second},
+		apiKey:      "test-api-key",
+		baseURL:     mockServer.URL,
+		breakers:    newOpenWeatherBreakers(circuitbreaker.Settings{MaxRequests: 1, Timeout: time.Minute, MinRequests: 2, FailureRatio: 1}, nil),
+		maxAttempts: 1,
+	}
+
+	// Act
+	_, firstErr := adapter.GetWeatherOverviewByLatLong(context.Background(), -0.13, 51.51, "")
+	_, secondErr := adapter.GetWeatherOverviewByLatLong(context.Background(), -0.13, 51.51, "")
+	_, rejectedErr := adapter.GetWeatherOverviewByLatLong(context.Background(), -0.13, 51.51, "")
+	weather, weatherErr := adapter.GetWeatherByCity(context.Background(), "London", language.English)
+
+	// Assert
+	assert.IsType(t, &support.ErrUpstream{}, firstErr)
+	assert.IsType(t, &support.ErrUpstream{}, secondErr)
+	assert.ErrorIs(t, rejectedErr, circuitbreaker.ErrOpenState, "the configured minimum of 2 failures trips the breaker")
+	assert.Equal(t, circuitbreaker.StateOpen, adapter.breakers[openWeatherAPIOneCallOverview].State())
+
+	assert.NoError(t, weatherErr)
+	if assert.NotNil(t, weather) {
+		assert.Equal(t, "London", weather.City)
+	}
+	assert.Equal(t, circuitbreaker.StateClosed, adapter.breakers[openWeatherAPIWeather].State())
+}
+
+func TestOpenWeatherAdapter_ForecastOutageKeepsWeatherAvailable(t *testing.T) {
+	// Arrange
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/data/2.5/forecast" {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"name":"London","main":{"temp":10,"humidity":80},"weather":[{"description":"rain"}]}`))
+	}))
+	defer mockServer.Close()
+
+	adapter := &OpenWeatherAdapter{
+		client:      &http.Client{Timeout: 10 * time.Second},
+		apiKey:      "test-api-key",
+		baseURL:     mockServer.URL,
+		breakers:    newOpenWeatherBreakers(circuitbreaker.Settings{MaxRequests: 1, Timeout: time.Minute, MinRequests: 1, FailureRatio: 1}, nil),
+		maxAttempts: 1,
+	}
+
+	// Act
+	_, forecastErr := adapter.GetForecastByCity(context.Background(), "London", language.English)
+	_, weatherErr := adapter.GetWeatherByCity(context.Background(), "London", language.English)
+
+	// Assert
+	assert.IsType(t, &support.ErrUpstream{}, forecastErr)
+	assert.Equal(t, circuitbreaker.StateOpen, adapter.breakers[openWeatherAPIForecast].State())
+	assert.NoError(t, weatherErr)
+	assert.Equal(t, circuitbreaker.StateClosed, adapter.breakers[openWeatherAPIWeather].State())
+}
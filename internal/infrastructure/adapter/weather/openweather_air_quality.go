@@ -0,0 +1,91 @@
+package weather
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"weather-api/internal/core/domain/entity"
+	"weather-api/internal/infrastructure/config"
+	"weather-api/pkg/circuitbreaker"
+)
+
+// OpenWeatherAirPollutionResponse mirrors the air pollution API payload shared by
+// the current, forecast and history endpoints.
+type OpenWeatherAirPollutionResponse struct {
+	Coord struct {
+		Lat float32 `json:"lat"`
+		Lon float32 `json:"lon"`
+	} `json:"coord"`
+
+	List []struct {
+		Dt   int64 `json:"dt"`
+		Main struct {
+			AQI int `json:"aqi"`
+		} `json:"main"`
+		Components struct {
+			CO    float64 `json:"co"`
+			NO    float64 `json:"no"`
+			NO2   float64 `json:"no2"`
+			O3    float64 `json:"o3"`
+			SO2   float64 `json:"so2"`
+			PM2_5 float64 `json:"pm2_5"`
+			PM10  float64 `json:"pm10"`
+			NH3   float64 `json:"nh3"`
+		} `json:"components"`
+	} `json:"list"`
+}
+
+func (a *OpenWeatherAdapter) GetAirQuality(ctx context.Context, lat float32, lon float32) (*entity.AirQuality, error) {
+	return a.executeAirQuality(ctx, fmt.Sprintf("%s/data/2.5/air_pollution?appid=%s&lat=%f&lon=%f", a.baseURL, a.apiKey, lat, lon), lat, lon)
+}
+
+func (a *OpenWeatherAdapter) GetAirQualityForecast(ctx context.Context, lat float32, lon float32) (*entity.AirQuality, error) {
+	return a.executeAirQuality(ctx, fmt.Sprintf("%s/data/2.5/air_pollution/forecast?appid=%s&lat=%f&lon=%f", a.baseURL, a.apiKey, lat, lon), lat, lon)
+}
+
+func (a *OpenWeatherAdapter) GetAirQualityHistory(ctx context.Context, lat float32, lon float32, start time.Time, end time.Time) (*entity.AirQuality, error) {
+	endpoint := fmt.Sprintf("%s/data/2.5/air_pollution/history?appid=%s&lat=%f&lon=%f&start=%d&end=%d",
+		a.baseURL, a.apiKey, lat, lon, start.Unix(), end.Unix())
+	return a.executeAirQuality(ctx, endpoint, lat, lon)
+}
+
+// executeAirQuality runs an air pollution request through the circuit breaker.
+func (a *OpenWeatherAdapter) executeAirQuality(ctx context.Context, endpoint string, lat float32, lon float32) (*entity.AirQuality, error) {
+	return circuitbreaker.Execute(ctx, a.breakers[openWeatherAPIAirPollution], func(ctx context.Context) (*entity.AirQuality, error) {
+		return a.fetchAirQualityData(ctx, endpoint, lat, lon)
+	})
+}
+
+// fetchAirQualityData requests an air pollution endpoint and maps the readings.
+func (a *OpenWeatherAdapter) fetchAirQualityData(ctx context.Context, endpoint string, lat float32, lon float32) (*entity.AirQuality, error) {
+	var apiResp OpenWeatherAirPollutionResponse
+	if err := a.getJSON(ctx, endpoint, fmt.Sprintf("air quality for lat '%f' , lon '%f' not found", lat, lon), &apiResp); err != nil {
+		return nil, err
+	}
+
+	readings := make([]entity.AirQualityReading, 0, len(apiResp.List))
+	for _, item := range apiResp.List {
+		readings = append(readings, entity.AirQualityReading{
+			Time: unixUTC(item.Dt),
+			AQI:  item.Main.AQI,
+			Components: entity.AirComponents{
+				CO:    item.Components.CO,
+				NO:    item.Components.NO,
+				NO2:   item.Components.NO2,
+				O3:    item.Components.O3,
+				SO2:   item.Components.SO2,
+				PM2_5: item.Components.PM2_5,
+				PM10:  item.Components.PM10,
+				NH3:   item.Components.NH3,
+			},
+		})
+	}
+
+	return &entity.AirQuality{
+		Lat:      apiResp.Coord.Lat,
+		Lon:      apiResp.Coord.Lon,
+		Readings: readings,
+		Provider: config.ProviderOpenWeather,
+	}, nil
+}
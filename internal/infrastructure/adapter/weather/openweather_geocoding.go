@@ -0,0 +1,62 @@
+package weather
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"weather-api/internal/core/domain/entity"
+	"weather-api/internal/core/domain/repository"
+	"weather-api/pkg/circuitbreaker"
+)
+
+// OpenWeatherGeoLocation mirrors one entry of the direct and reverse geocoding payloads.
+type OpenWeatherGeoLocation struct {
+	Name       string            `json:"name"`
+	LocalNames map[string]string `json:"local_names"`
+	Lat        float32           `json:"lat"`
+	Lon        float32           `json:"lon"`
+	Country    string            `json:"country"`
+	State      string            `json:"state"`
+}
+
+func (a *OpenWeatherAdapter) SearchLocations(ctx context.Context, query string, limit int) ([]entity.Location, error) {
+	endpoint := fmt.Sprintf("%s/geo/1.0/direct?q=%s&limit=%d&appid=%s", a.baseURL, url.QueryEscape(query), limit, a.apiKey)
+	return a.executeGeocoding(ctx, endpoint, fmt.Sprintf("location '%s' not found", query))
+}
+
+func (a *OpenWeatherAdapter) ReverseGeocode(ctx context.Context, lat float32, lon float32, limit int) ([]entity.Location, error) {
+	endpoint := fmt.Sprintf("%s/geo/1.0/reverse?lat=%f&lon=%f&limit=%d&appid=%s", a.baseURL, lat, lon, limit, a.apiKey)
+	return a.executeGeocoding(ctx, endpoint, fmt.Sprintf("lat '%f' , lon '%f' not found", lat, lon))
+}
+
+// executeGeocoding runs a geocoding request through the circuit breaker.
+func (a *OpenWeatherAdapter) executeGeocoding(ctx context.Context, endpoint string, notFoundMsg string) ([]entity.Location, error) {
+	return circuitbreaker.Execute(ctx, a.breakers[openWeatherAPIGeocoding], func(ctx context.Context) ([]entity.Location, error) {
+		return a.fetchGeocodingData(ctx, endpoint, notFoundMsg)
+	})
+}
+
+// fetchGeocodingData requests a geocoding endpoint and maps every match.
+func (a *OpenWeatherAdapter) fetchGeocodingData(ctx context.Context, endpoint string, notFoundMsg string) ([]entity.Location, error) {
+	var apiResp []OpenWeatherGeoLocation
+	if err := a.getJSON(ctx, endpoint, notFoundMsg, &apiResp); err != nil {
+		return nil, err
+	}
+
+	locations := make([]entity.Location, 0, len(apiResp))
+	for _, loc := range apiResp {
+		locations = append(locations, entity.Location{
+			Name:       loc.Name,
+			LocalNames: loc.LocalNames,
+			Country:    loc.Country,
+			State:      loc.State,
+			Lat:        loc.Lat,
+			Lon:        loc.Lon,
+		})
+	}
+
+	return locations, nil
+}
+
+var _ repository.GeocodingRepository = (*OpenWeatherAdapter)(nil)
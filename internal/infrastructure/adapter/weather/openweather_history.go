@@ -0,0 +1,157 @@
+package weather
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"weather-api/internal/core/domain/entity"
+	"weather-api/internal/infrastructure/config"
+	"weather-api/pkg/circuitbreaker"
+	"weather-api/pkg/language"
+	"weather-api/pkg/units"
+)
+
+// OpenWeatherTimeMachineResponse mirrors the One Call 3.0 timemachine payload.
+type OpenWeatherTimeMachineResponse struct {
+	Lat      float32 `json:"lat"`
+	Lon      float32 `json:"lon"`
+	Timezone string  `json:"timezone"`
+
+	Data []struct {
+		Dt         int64         `json:"dt"`
+		Temp       float64       `json:"temp"`
+		FeelsLike  float64       `json:"feels_like"`
+		Pressure   int           `json:"pressure"`
+		Humidity   int           `json:"humidity"`
+		DewPoint   float64       `json:"dew_point"`
+		Clouds     int           `json:"clouds"`
+		Visibility int           `json:"visibility"`
+		WindSpeed  float64       `json:"wind_speed"`
+		WindDeg    int           `json:"wind_deg"`
+		Weather    []owCondition `json:"weather"`
+	} `json:"data"`
+}
+
+// OpenWeatherDaySummaryResponse mirrors the One Call 3.0 day_summary payload.
+type OpenWeatherDaySummaryResponse struct {
+	Lat  float32 `json:"lat"`
+	Lon  float32 `json:"lon"`
+	Date string  `json:"date"`
+
+	CloudCover struct {
+		Afternoon float64 `json:"afternoon"`
+	} `json:"cloud_cover"`
+	Humidity struct {
+		Afternoon float64 `json:"afternoon"`
+	} `json:"humidity"`
+	Precipitation struct {
+		Total float64 `json:"total"`
+	} `json:"precipitation"`
+	Temperature struct {
+		Min       float64 `json:"min"`
+		Max       float64 `json:"max"`
+		Morning   float64 `json:"morning"`
+		Afternoon float64 `json:"afternoon"`
+		Evening   float64 `json:"evening"`
+		Night     float64 `json:"night"`
+	} `json:"temperature"`
+	Pressure struct {
+		Afternoon float64 `json:"afternoon"`
+	} `json:"pressure"`
+	Wind struct {
+		Max struct {
+			Speed     float64 `json:"speed"`
+			Direction float64 `json:"direction"`
+		} `json:"max"`
+	} `json:"wind"`
+}
+
+// daySummaryDateLayout is the date format used by the day_summary endpoint.
+const daySummaryDateLayout = "2006-01-02"
+
+func (a *OpenWeatherAdapter) GetHistoricalWeather(ctx context.Context, lat float32, lon float32, at time.Time, lang language.Code) (*entity.HistoricalWeather, error) {
+	return circuitbreaker.Execute(ctx, a.breakers[openWeatherAPIOneCallTimemachine], func(ctx context.Context) (*entity.HistoricalWeather, error) {
+		return a.fetchHistoricalWeatherData(ctx, lat, lon, at, lang)
+	})
+}
+
+func (a *OpenWeatherAdapter) GetDaySummary(ctx context.Context, lat float32, lon float32, date time.Time) (*entity.DaySummary, error) {
+	return circuitbreaker.Execute(ctx, a.breakers[openWeatherAPIOneCallDaySummary], func(ctx context.Context) (*entity.DaySummary, error) {
+		return a.fetchDaySummaryData(ctx, lat, lon, date)
+	})
+}
+
+// fetchHistoricalWeatherData requests the observations recorded at a past timestamp.
+func (a *OpenWeatherAdapter) fetchHistoricalWeatherData(ctx context.Context, lat float32, lon float32, at time.Time, lang language.Code) (*entity.HistoricalWeather, error) {
+	endpoint := fmt.Sprintf("%s/data/3.0/onecall/timemachine?appid=%s&lat=%f&lon=%f&dt=%d&units=metric&lang=%s",
+		a.baseURL, a.apiKey, lat, lon, at.Unix(), lang.OrDefault())
+
+	var apiResp OpenWeatherTimeMachineResponse
+	if err := a.getJSON(ctx, endpoint, fmt.Sprintf("history for lat '%f' , lon '%f' not found", lat, lon), &apiResp); err != nil {
+		return nil, err
+	}
+
+	observations := make([]entity.HistoricalObservation, 0, len(apiResp.Data))
+	for _, d := range apiResp.Data {
+		observations = append(observations, entity.HistoricalObservation{
+			Time:        unixUTC(d.Dt),
+			Temperature: d.Temp,
+			FeelsLike:   d.FeelsLike,
+			Pressure:    d.Pressure,
+			Humidity:    d.Humidity,
+			DewPoint:    d.DewPoint,
+			Clouds:      d.Clouds,
+			Visibility:  d.Visibility,
+			WindSpeed:   d.WindSpeed,
+			WindDeg:     d.WindDeg,
+			Description: firstDescription(d.Weather),
+		})
+	}
+
+	return &entity.HistoricalWeather{
+		Lat:          apiResp.Lat,
+		Lon:          apiResp.Lon,
+		Timezone:     apiResp.Timezone,
+		Observations: observations,
+		Provider:     config.ProviderOpenWeather,
+		Units:        units.Metric,
+		Language:     lang.OrDefault(),
+	}, nil
+}
+
+// fetchDaySummaryData requests the aggregated weather for one calendar day.
+func (a *OpenWeatherAdapter) fetchDaySummaryData(ctx context.Context, lat float32, lon float32, date time.Time) (*entity.DaySummary, error) {
+	day := date.Format(daySummaryDateLayout)
+	endpoint := fmt.Sprintf("%s/data/3.0/onecall/day_summary?appid=%s&lat=%f&lon=%f&date=%s&units=metric",
+		a.baseURL, a.apiKey, lat, lon, day)
+
+	var apiResp OpenWeatherDaySummaryResponse
+	if err := a.getJSON(ctx, endpoint, fmt.Sprintf("day summary for '%s' not found", day), &apiResp); err != nil {
+		return nil, err
+	}
+
+	summaryDate, err := time.Parse(daySummaryDateLayout, apiResp.Date)
+	if err != nil {
+		summaryDate, _ = time.Parse(daySummaryDateLayout, day)
+	}
+
+	return &entity.DaySummary{
+		Lat:                 apiResp.Lat,
+		Lon:                 apiResp.Lon,
+		Date:                summaryDate,
+		TempMin:             apiResp.Temperature.Min,
+		TempMax:             apiResp.Temperature.Max,
+		TempMorning:         apiResp.Temperature.Morning,
+		TempAfternoon:       apiResp.Temperature.Afternoon,
+		TempEvening:         apiResp.Temperature.Evening,
+		TempNight:           apiResp.Temperature.Night,
+		HumidityAfternoon:   apiResp.Humidity.Afternoon,
+		CloudCoverAfternoon: apiResp.CloudCover.Afternoon,
+		PressureAfternoon:   apiResp.Pressure.Afternoon,
+		Precipitation:       apiResp.Precipitation.Total,
+		WindMaxSpeed:        apiResp.Wind.Max.Speed,
+		WindMaxDirection:    apiResp.Wind.Max.Direction,
+		Units:               units.Metric,
+	}, nil
+}
@@ -0,0 +1,220 @@
+package weather
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"weather-api/internal/core/domain/entity"
+	"weather-api/internal/infrastructure/config"
+	"weather-api/pkg/circuitbreaker"
+	"weather-api/pkg/language"
+	"weather-api/pkg/units"
+)
+
+// owCondition is the shared shape of the "weather" array entries.
+type owCondition struct {
+	Description string `json:"description"`
+}
+
+// OpenWeatherOneCallResponse mirrors the One Call 3.0 payload.
+type OpenWeatherOneCallResponse struct {
+	Lat            float32 `json:"lat"`
+	Lon            float32 `json:"lon"`
+	Timezone       string  `json:"timezone"`
+	TimezoneOffset int     `json:"timezone_offset"`
+
+	Current *struct {
+		Dt         int64         `json:"dt"`
+		Sunrise    int64         `json:"sunrise"`
+		Sunset     int64         `json:"sunset"`
+		Temp       float64       `json:"temp"`
+		FeelsLike  float64       `json:"feels_like"`
+		Pressure   int           `json:"pressure"`
+		Humidity   int           `json:"humidity"`
+		DewPoint   float64       `json:"dew_point"`
+		UVI        float64       `json:"uvi"`
+		Clouds     int           `json:"clouds"`
+		Visibility int           `json:"visibility"`
+		WindSpeed  float64       `json:"wind_speed"`
+		WindDeg    int           `json:"wind_deg"`
+		Weather    []owCondition `json:"weather"`
+	} `json:"current"`
+
+	Minutely []struct {
+		Dt            int64   `json:"dt"`
+		Precipitation float64 `json:"precipitation"`
+	} `json:"minutely"`
+
+	Hourly []struct {
+		Dt        int64         `json:"dt"`
+		Temp      float64       `json:"temp"`
+		FeelsLike float64       `json:"feels_like"`
+		Humidity  int           `json:"humidity"`
+		WindSpeed float64       `json:"wind_speed"`
+		Weather   []owCondition `json:"weather"`
+		Pop       float64       `json:"pop"`
+	} `json:"hourly"`
+
+	Daily []struct {
+		Dt      int64  `json:"dt"`
+		Summary string `json:"summary"`
+		Temp    struct {
+			Day   float64 `json:"day"`
+			Min   float64 `json:"min"`
+			Max   float64 `json:"max"`
+			Night float64 `json:"night"`
+		} `json:"temp"`
+		Humidity  int           `json:"humidity"`
+		WindSpeed float64       `json:"wind_speed"`
+		Weather   []owCondition `json:"weather"`
+		Pop       float64       `json:"pop"`
+		Rain      float64       `json:"rain"`
+		UVI       float64       `json:"uvi"`
+	} `json:"daily"`
+
+	Alerts []struct {
+		SenderName  string   `json:"sender_name"`
+		Event       string   `json:"event"`
+		Start       int64    `json:"start"`
+		End         int64    `json:"end"`
+		Description string   `json:"description"`
+		Tags        []string `json:"tags"`
+	} `json:"alerts"`
+}
+
+func (a *OpenWeatherAdapter) GetOneCall(ctx context.Context, lat float32, lon float32, include []entity.OneCallBlock, lang language.Code) (*entity.OneCall, error) {
+	return circuitbreaker.Execute(ctx, a.breakers[openWeatherAPIOneCall], func(ctx context.Context) (*entity.OneCall, error) {
+		return a.fetchOneCallData(ctx, lat, lon, include, lang)
+	})
+}
+
+// fetchOneCallData requests the One Call 3.0 payload, excluding every block not listed in include.
+// An empty include requests all blocks.
+func (a *OpenWeatherAdapter) fetchOneCallData(ctx context.Context, lat float32, lon float32, include []entity.OneCallBlock, lang language.Code) (*entity.OneCall, error) {
+	endpoint := fmt.Sprintf("%s/data/3.0/onecall?appid=%s&lat=%f&lon=%f&units=metric&lang=%s", a.baseURL, a.apiKey, lat, lon, lang.OrDefault())
+	if exclude := excludedBlocks(include); exclude != "" {
+		endpoint += "&exclude=" + exclude
+	}
+
+	var apiResp OpenWeatherOneCallResponse
+	if err := a.getJSON(ctx, endpoint, fmt.Sprintf("lat '%f' , lon '%f' not found", lat, lon), &apiResp); err != nil {
+		return nil, err
+	}
+
+	oneCall := mapOneCall(&apiResp)
+	oneCall.Language = lang.OrDefault()
+	return oneCall, nil
+}
+
+// excludedBlocks returns the comma separated complement of include.
+func excludedBlocks(include []entity.OneCallBlock) string {
+	if len(include) == 0 {
+		return ""
+	}
+
+	wanted := make(map[entity.OneCallBlock]bool, len(include))
+	for _, block := range include {
+		wanted[block] = true
+	}
+
+	var excluded []string
+	for _, block := range entity.AllOneCallBlocks() {
+		if !wanted[block] {
+			excluded = append(excluded, string(block))
+		}
+	}
+	return strings.Join(excluded, ",")
+}
+
+// firstDescription returns the first condition description or an empty string.
+func firstDescription(conditions []owCondition) string {
+	if len(conditions) == 0 {
+		return ""
+	}
+	return conditions[0].Description
+}
+
+func unixUTC(seconds int64) time.Time {
+	return time.Unix(seconds, 0).UTC()
+}
+
+func mapOneCall(apiResp *OpenWeatherOneCallResponse) *entity.OneCall {
+	oneCall := &entity.OneCall{
+		Lat:            apiResp.Lat,
+		Lon:            apiResp.Lon,
+		Timezone:       apiResp.Timezone,
+		TimezoneOffset: apiResp.TimezoneOffset,
+		Provider:       config.ProviderOpenWeather,
+		Units:          units.Metric,
+	}
+
+	if cur := apiResp.Current; cur != nil {
+		oneCall.Current = &entity.CurrentConditions{
+			Time:        unixUTC(cur.Dt),
+			Sunrise:     unixUTC(cur.Sunrise),
+			Sunset:      unixUTC(cur.Sunset),
+			Temperature: cur.Temp,
+			FeelsLike:   cur.FeelsLike,
+			Pressure:    cur.Pressure,
+			Humidity:    cur.Humidity,
+			DewPoint:    cur.DewPoint,
+			UVIndex:     cur.UVI,
+			Clouds:      cur.Clouds,
+			Visibility:  cur.Visibility,
+			WindSpeed:   cur.WindSpeed,
+			WindDeg:     cur.WindDeg,
+			Description: firstDescription(cur.Weather),
+		}
+	}
+
+	for _, m := range apiResp.Minutely {
+		oneCall.Minutely = append(oneCall.Minutely, entity.MinutelyPrecipitation{
+			Time:          unixUTC(m.Dt),
+			Precipitation: m.Precipitation,
+		})
+	}
+
+	for _, h := range apiResp.Hourly {
+		oneCall.Hourly = append(oneCall.Hourly, entity.HourlyConditions{
+			Time:                     unixUTC(h.Dt),
+			Temperature:              h.Temp,
+			FeelsLike:                h.FeelsLike,
+			Humidity:                 h.Humidity,
+			WindSpeed:                h.WindSpeed,
+			Description:              firstDescription(h.Weather),
+			PrecipitationProbability: h.Pop,
+		})
+	}
+
+	for _, d := range apiResp.Daily {
+		oneCall.Daily = append(oneCall.Daily, entity.DailyConditions{
+			Date:                     unixUTC(d.Dt),
+			Summary:                  d.Summary,
+			TempMin:                  d.Temp.Min,
+			TempMax:                  d.Temp.Max,
+			TempDay:                  d.Temp.Day,
+			TempNight:                d.Temp.Night,
+			Humidity:                 d.Humidity,
+			WindSpeed:                d.WindSpeed,
+			Description:              firstDescription(d.Weather),
+			PrecipitationProbability: d.Pop,
+			Rain:                     d.Rain,
+			UVIndex:                  d.UVI,
+		})
+	}
+
+	for _, alert := range apiResp.Alerts {
+		oneCall.Alerts = append(oneCall.Alerts, entity.WeatherAlert{
+			SenderName:  alert.SenderName,
+			Event:       alert.Event,
+			Start:       unixUTC(alert.Start),
+			End:         unixUTC(alert.End),
+			Description: alert.Description,
+			Tags:        alert.Tags,
+		})
+	}
+
+	return oneCall
+}
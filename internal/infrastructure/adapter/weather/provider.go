@@ -0,0 +1,32 @@
+package weather
+
+import (
+	"fmt"
+
+	"weather-api/internal/core/domain/repository"
+	"weather-api/internal/infrastructure/config"
+
+	"go.uber.org/zap"
+)
+
+// Provider is an upstream weather source that also resolves locations.
+type Provider interface {
+	repository.WeatherRepository
+	repository.GeocodingRepository
+}
+
+// NewProvider builds the adapter for a provider name from config, logging breaker state changes to logger.
+// OpenWeather requires an API key and counts its calls against quota, which may be nil; Open-Meteo needs neither.
+func NewProvider(name string, cfg *config.Config, quota *QuotaManager, logger *zap.Logger) (Provider, error) {
+	switch name {
+	case config.ProviderOpenWeather:
+		if cfg.Weather.APIKey == "" {
+			return nil, fmt.Errorf("OPENWEATHER_API_KEY environment variable is required for provider %q", name)
+		}
+		return NewOpenWeatherAdapterWithConfig(cfg.Weather, quota, logger), nil
+	case config.ProviderOpenMeteo:
+		return NewOpenMeteoAdapter(cfg.OpenMeteo, logger), nil
+	default:
+		return nil, fmt.Errorf("unsupported weather provider %q (expected %q or %q)", name, config.ProviderOpenWeather, config.ProviderOpenMeteo)
+	}
+}
@@ -0,0 +1,132 @@
+package weather
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"weather-api/internal/core/domain/entity"
+	"weather-api/internal/infrastructure/config"
+	"weather-api/internal/infrastructure/support"
+)
+
+// OpenWeather endpoints with separate call budgets. Geocoding is billed with the 2.5 APIs.
+const (
+	QuotaEndpointWeather = "2.5"
+	QuotaEndpointOneCall = "3.0"
+)
+
+// QuotaManager counts outgoing OpenWeather calls per endpoint in fixed clock-minute and UTC-day
+// windows and refuses calls once a budget is used up. One manager is shared by every OpenWeather
+// adapter, since they all spend the same account's quota. A nil manager allows every call.
+type QuotaManager struct {
+	mu       sync.Mutex
+	budgets  map[string]config.QuotaBudget
+	counters map[string]*quotaCounter
+	now      func() time.Time
+}
+
+type quotaCounter struct {
+	minute      time.Time
+	minuteCalls int
+	day         time.Time
+	dayCalls    int
+	rejected    uint64
+}
+
+// NewQuotaManager creates a manager enforcing the budgets in cfg.
+func NewQuotaManager(cfg config.QuotaConfig) *QuotaManager {
+	return &QuotaManager{
+		budgets: map[string]config.QuotaBudget{
+			QuotaEndpointWeather: cfg.Weather,
+			QuotaEndpointOneCall: cfg.OneCall,
+		},
+		counters: map[string]*quotaCounter{
+			QuotaEndpointWeather: {},
+			QuotaEndpointOneCall: {},
+		},
+		now: time.Now,
+	}
+}
+
+// quotaEndpoint returns the budgeted endpoint a request URL is billed to.
+func quotaEndpoint(rawURL string) string {
+	if strings.Contains(rawURL, "/data/3.0/") {
+		return QuotaEndpointOneCall
+	}
+	return QuotaEndpointWeather
+}
+
+// Reserve counts one call to endpoint, or returns a *support.ErrQuotaExceeded without counting it when
+// the minute or day budget is used up. When both are, the error reports the later reset.
+func (q *QuotaManager) Reserve(endpoint string) error {
+	if q == nil {
+		return nil
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	now := q.now()
+	counter := q.roll(endpoint, now)
+	budget := q.budgets[endpoint]
+
+	var window string
+	var limit int
+	var retryAfter time.Duration
+	if budget.PerMinute > 0 && counter.minuteCalls >= budget.PerMinute {
+		window, limit, retryAfter = "per-minute", budget.PerMinute, counter.minute.Add(time.Minute).Sub(now)
+	}
+	if budget.PerDay > 0 && counter.dayCalls >= budget.PerDay {
+		window, limit, retryAfter = "daily", budget.PerDay, counter.day.AddDate(0, 0, 1).Sub(now)
+	}
+	if window != "" {
+		counter.rejected++
+		retryAfter = retryAfter.Round(time.Second)
+		return support.NewErrQuotaExceeded(fmt.Sprintf("openweather %s %s quota of %d calls exhausted, retry in %s", endpoint, window, limit, retryAfter), retryAfter)
+	}
+
+	counter.minuteCalls++
+	counter.dayCalls++
+	return nil
+}
+
+// Usage returns a snapshot of every endpoint's counters, in endpoint order.
+func (q *QuotaManager) Usage() []entity.QuotaUsage {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	now := q.now()
+	usage := make([]entity.QuotaUsage, 0, len(q.counters))
+	for _, endpoint := range []string{QuotaEndpointWeather, QuotaEndpointOneCall} {
+		counter := q.roll(endpoint, now)
+		budget := q.budgets[endpoint]
+		usage = append(usage, entity.QuotaUsage{
+			Endpoint: endpoint,
+			Minute:   entity.QuotaWindow{Used: counter.minuteCalls, Limit: budget.PerMinute, ResetAt: counter.minute.Add(time.Minute)},
+			Day:      entity.QuotaWindow{Used: counter.dayCalls, Limit: budget.PerDay, ResetAt: counter.day.AddDate(0, 0, 1)},
+			Rejected: counter.rejected,
+		})
+	}
+	return usage
+}
+
+// roll returns the counter for endpoint with any window that ended before now started over.
+// Callers must hold q.mu.
+func (q *QuotaManager) roll(endpoint string, now time.Time) *quotaCounter {
+	counter, ok := q.counters[endpoint]
+	if !ok {
+		counter = &quotaCounter{}
+		q.counters[endpoint] = counter
+	}
+
+	now = now.UTC()
+	if minute := now.Truncate(time.Minute); !minute.Equal(counter.minute) {
+		counter.minute, counter.minuteCalls = minute, 0
+	}
+	if day := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC); !day.Equal(counter.day) {
+		counter.day, counter.dayCalls = day, 0
+	}
+	return counter
+}
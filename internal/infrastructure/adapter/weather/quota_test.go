@@ -0,0 +1,154 @@
+package weather
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"weather-api/internal/infrastructure/config"
+	"weather-api/internal/infrastructure/support"
+	"weather-api/pkg/circuitbreaker"
+	"weather-api/pkg/language"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestQuotaManager(cfg config.QuotaConfig, now *time.Time) *QuotaManager {
+	quota := NewQuotaManager(cfg)
+	quota.now = func() time.Time { return *now }
+	return quota
+}
+
+func TestQuotaManager_Reserve_MinuteWindow(t *testing.T) {
+	// Arrange
+	now := time.Date(2024, 1, 1, 12, 0, 15, 0, time.UTC)
+	quota := newTestQuotaManager(config.QuotaConfig{Weather: config.QuotaBudget{PerMinute: 2}}, &now)
+
+	// Act
+	first := quota.Reserve(QuotaEndpointWeather)
+	second := quota.Reserve(QuotaEndpointWeather)
+	refused := quota.Reserve(QuotaEndpointWeather)
+	oneCall := quota.Reserve(QuotaEndpointOneCall)
+	now = now.Add(45 * time.Second)
+	nextMinute := quota.Reserve(QuotaEndpointWeather)
+
+	// Assert
+	assert.NoError(t, first)
+	assert.NoError(t, second)
+	var quotaErr *support.ErrQuotaExceeded
+	if assert.ErrorAs(t, refused, &quotaErr) {
+		assert.Equal(t, 45*time.Second, quotaErr.RetryAfter)
+		assert.Equal(t, "openweather 2.5 per-minute quota of 2 calls exhausted, retry in 45s", quotaErr.Error())
+	}
+	assert.NoError(t, oneCall, "endpoints have separate budgets")
+	assert.NoError(t, nextMinute)
+
+	usage := quota.Usage()
+	assert.Equal(t, QuotaEndpointWeather, usage[0].Endpoint)
+	assert.Equal(t, 1, usage[0].Minute.Used)
+	assert.Equal(t, 3, usage[0].Day.Used, "the refused call is not counted")
+	assert.Equal(t, uint64(1), usage[0].Rejected)
+	assert.Equal(t, -1, usage[0].Day.Remaining())
+	assert.Equal(t, QuotaEndpointOneCall, usage[1].Endpoint)
+	assert.Equal(t, 1, usage[1].Day.Used)
+}
+
+func TestQuotaManager_Reserve_DayWindow(t *testing.T) {
+	// Arrange
+	now := time.Date(2024, 1, 1, 23, 30, 0, 0, time.UTC)
+	quota := newTestQuotaManager(config.QuotaConfig{OneCall: config.QuotaBudget{PerMinute: 1, PerDay: 1}}, &now)
+
+	// Act
+	first := quota.Reserve(QuotaEndpointOneCall)
+	refused := quota.Reserve(QuotaEndpointOneCall)
+	now = now.Add(30 * time.Minute)
+	nextDay := quota.Reserve(QuotaEndpointOneCall)
+
+	// Assert
+	assert.NoError(t, first)
+	var quotaErr *support.ErrQuotaExceeded
+	if assert.ErrorAs(t, refused, &quotaErr) {
+		assert.Equal(t, 30*time.Minute, quotaErr.RetryAfter, "the later reset wins when both budgets are used up")
+		assert.Contains(t, quotaErr.Error(), "daily quota of 1 calls")
+	}
+	assert.True(t, errors.Is(refused, circuitbreaker.ErrNotAttempted))
+	assert.NoError(t, nextDay)
+}
+
+func TestQuotaEndpoint(t *testing.T) {
+	assert.Equal(t, QuotaEndpointWeather, quotaEndpoint("https://api.openweathermap.org/data/2.5/weather?q=London"))
+	assert.Equal(t, QuotaEndpointWeather, quotaEndpoint("https://api.openweathermap.org/geo/1.0/direct?q=London"))
+	assert.Equal(t, QuotaEndpointOneCall, quotaEndpoint("https://api.openweathermap.org/data/3.0/onecall/overview?lat=1"))
+}
+
+func TestOpenWeatherAdapter_QuotaExhausted_DoesNotCallUpstream(t *testing.T) {
+	// Arrange
+	var calls atomic.Int64
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"name":"London","main":{"temp":10}}`))
+	}))
+	defer mockServer.Close()
+
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	adapter := &OpenWeatherAdapter{
+		client:      &http.Client{Timeout: 10 * time.Second},
+		apiKey:      "test-api-key",
+		baseURL:     mockServer.URL,
+		breakers:    newOpenWeatherBreakers(circuitbreaker.DefaultSettings(), nil),
+		maxAttempts: 1,
+		quota:       newTestQuotaManager(config.QuotaConfig{Weather: config.QuotaBudget{PerDay: 1}}, &now),
+	}
+
+	// Act
+	_, first := adapter.GetWeatherByCity(context.Background(), "London", language.English)
+	var refused []error
+	for i := 0; i < 5; i++ {
+		_, err := adapter.GetWeatherByCity(context.Background(), "London", language.English)
+		refused = append(refused, err)
+	}
+
+	// Assert
+	assert.NoError(t, first)
+	assert.Equal(t, int64(1), calls.Load())
+	for _, err := range refused {
+		assert.IsType(t, &support.ErrQuotaExceeded{}, err, "refused calls must not trip the breaker")
+	}
+}
+
+func TestOpenWeatherAdapter_QuotaExhausted_StopsRetrying(t *testing.T) {
+	// Arrange
+	var calls atomic.Int64
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer mockServer.Close()
+
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	adapter := &OpenWeatherAdapter{
+		client:         &http.Client{Timeout: 10 * time.Second},
+		apiKey:         "test-api-key",
+		baseURL:        mockServer.URL,
+		breakers:       newOpenWeatherBreakers(circuitbreaker.DefaultSettings(), nil),
+		maxAttempts:    3,
+		initialBackoff: time.Millisecond,
+		maxBackoff:     time.Millisecond,
+		quota:          newTestQuotaManager(config.QuotaConfig{Weather: config.QuotaBudget{PerMinute: 2}}, &now),
+	}
+
+	// Act
+	_, err := adapter.GetWeatherByCity(context.Background(), "London", language.English)
+
+	// Assert
+	var upstreamErr *support.ErrUpstream
+	if assert.ErrorAs(t, err, &upstreamErr) {
+		assert.Equal(t, http.StatusServiceUnavailable, upstreamErr.StatusCode)
+	}
+	assert.Equal(t, int64(2), calls.Load(), "every attempt counts against the budget")
+}
@@ -0,0 +1,300 @@
+package weather
+
+import (
+	"context"
+	"math"
+	"math/rand/v2"
+	"sync"
+	"time"
+
+	"weather-api/internal/core/domain/entity"
+	"weather-api/internal/core/domain/repository"
+	"weather-api/internal/infrastructure/config"
+	"weather-api/pkg/language"
+	"weather-api/pkg/units"
+)
+
+// ShadowWeatherRepository serves every call from next and replays a sampled share of the successful
+// ones against a candidate in the background, recording how far the candidate's answers diverge.
+// The candidate never affects the result or the latency seen by the caller: shadow calls run after
+// the primary answer is available, are bounded by their own timeout and are dropped when too many
+// are already in flight. Current weather, forecast and current air quality are compared.
+type ShadowWeatherRepository struct {
+	next       repository.WeatherRepository
+	candidate  repository.WeatherRepository
+	name       string
+	percent    float64
+	timeout    time.Duration
+	tolerances config.ShadowTolerances
+	slots      chan struct{}
+	sample     func() bool
+
+	mu    sync.Mutex
+	stats map[string]*shadowOperationCounters
+}
+
+type shadowOperationCounters struct {
+	sampled         uint64
+	dropped         uint64
+	candidateErrors uint64
+	compared        uint64
+	divergent       uint64
+	fields          map[string]*shadowFieldCounters
+}
+
+type shadowFieldCounters struct {
+	compared   uint64
+	diverged   uint64
+	sumAbsDiff float64
+	maxAbsDiff float64
+}
+
+// fieldDiff is one compared field of a primary and a candidate response.
+type fieldDiff struct {
+	field     string
+	primary   float64
+	candidate float64
+	tolerance float64
+}
+
+// NewShadowWeatherRepository wraps next so that cfg.SamplePercent of its successful calls are replayed against candidate.
+func NewShadowWeatherRepository(next repository.WeatherRepository, candidate repository.WeatherRepository, cfg config.ShadowConfig) *ShadowWeatherRepository {
+	maxInFlight := cfg.MaxInFlight
+	if maxInFlight <= 0 {
+		maxInFlight = 1
+	}
+
+	r := &ShadowWeatherRepository{
+		next:       next,
+		candidate:  candidate,
+		name:       cfg.Provider,
+		percent:    cfg.SamplePercent,
+		timeout:    cfg.Timeout,
+		tolerances: cfg.Tolerances,
+		slots:      make(chan struct{}, maxInFlight),
+		stats:      make(map[string]*shadowOperationCounters),
+	}
+	r.sample = func() bool { return rand.Float64()*100 < r.percent }
+	return r
+}
+
+func (r *ShadowWeatherRepository) GetWeatherByCity(ctx context.Context, city string, lang language.Code) (*entity.Weather, error) {
+	weather, err := r.next.GetWeatherByCity(ctx, city, lang)
+	if err == nil {
+		shadow(ctx, r, cacheOpCurrent, weather, func(ctx context.Context) (*entity.Weather, error) {
+			return r.candidate.GetWeatherByCity(ctx, city, lang)
+		}, r.diffWeather)
+	}
+	return weather, err
+}
+
+func (r *ShadowWeatherRepository) GetWeatherByCoordinates(ctx context.Context, lat float32, lon float32, lang language.Code) (*entity.Weather, error) {
+	weather, err := r.next.GetWeatherByCoordinates(ctx, lat, lon, lang)
+	if err == nil {
+		shadow(ctx, r, cacheOpCurrent, weather, func(ctx context.Context) (*entity.Weather, error) {
+			return r.candidate.GetWeatherByCoordinates(ctx, lat, lon, lang)
+		}, r.diffWeather)
+	}
+	return weather, err
+}
+
+func (r *ShadowWeatherRepository) GetWeatherOverviewByLatLong(ctx context.Context, lon float32, lat float32, system units.System) (*entity.WeatherOverview, error) {
+	return r.next.GetWeatherOverviewByLatLong(ctx, lon, lat, system)
+}
+
+func (r *ShadowWeatherRepository) GetForecastByCity(ctx context.Context, city string, lang language.Code) (*entity.Forecast, error) {
+	forecast, err := r.next.GetForecastByCity(ctx, city, lang)
+	if err == nil {
+		shadow(ctx, r, cacheOpForecast, forecast, func(ctx context.Context) (*entity.Forecast, error) {
+			return r.candidate.GetForecastByCity(ctx, city, lang)
+		}, r.diffForecast)
+	}
+	return forecast, err
+}
+
+func (r *ShadowWeatherRepository) GetOneCall(ctx context.Context, lat float32, lon float32, include []entity.OneCallBlock, lang language.Code) (*entity.OneCall, error) {
+	return r.next.GetOneCall(ctx, lat, lon, include, lang)
+}
+
+func (r *ShadowWeatherRepository) GetAirQuality(ctx context.Context, lat float32, lon float32) (*entity.AirQuality, error) {
+	airQuality, err := r.next.GetAirQuality(ctx, lat, lon)
+	if err == nil {
+		shadow(ctx, r, cacheOpAirQuality, airQuality, func(ctx context.Context) (*entity.AirQuality, error) {
+			return r.candidate.GetAirQuality(ctx, lat, lon)
+		}, r.diffAirQuality)
+	}
+	return airQuality, err
+}
+
+func (r *ShadowWeatherRepository) GetAirQualityForecast(ctx context.Context, lat float32, lon float32) (*entity.AirQuality, error) {
+	return r.next.GetAirQualityForecast(ctx, lat, lon)
+}
+
+func (r *ShadowWeatherRepository) GetAirQualityHistory(ctx context.Context, lat float32, lon float32, start time.Time, end time.Time) (*entity.AirQuality, error) {
+	return r.next.GetAirQualityHistory(ctx, lat, lon, start, end)
+}
+
+func (r *ShadowWeatherRepository) GetHistoricalWeather(ctx context.Context, lat float32, lon float32, at time.Time, lang language.Code) (*entity.HistoricalWeather, error) {
+	return r.next.GetHistoricalWeather(ctx, lat, lon, at, lang)
+}
+
+func (r *ShadowWeatherRepository) GetDaySummary(ctx context.Context, lat float32, lon float32, date time.Time) (*entity.DaySummary, error) {
+	return r.next.GetDaySummary(ctx, lat, lon, date)
+}
+
+// Stats returns a snapshot of the divergence statistics per operation and field.
+func (r *ShadowWeatherRepository) Stats() entity.ShadowStats {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	snapshot := entity.ShadowStats{
+		Candidate:     r.name,
+		SamplePercent: r.percent,
+		Operations:    make(map[string]entity.ShadowOperationStats, len(r.stats)),
+	}
+	for op, counters := range r.stats {
+		fields := make(map[string]entity.ShadowFieldStats, len(counters.fields))
+		for field, fc := range counters.fields {
+			fields[field] = entity.ShadowFieldStats{
+				Compared:    fc.compared,
+				Diverged:    fc.diverged,
+				MeanAbsDiff: fc.sumAbsDiff / float64(fc.compared),
+				MaxAbsDiff:  fc.maxAbsDiff,
+			}
+		}
+		snapshot.Operations[op] = entity.ShadowOperationStats{
+			Sampled:         counters.sampled,
+			Dropped:         counters.dropped,
+			CandidateErrors: counters.candidateErrors,
+			Compared:        counters.compared,
+			Divergent:       counters.divergent,
+			Fields:          fields,
+		}
+	}
+	return snapshot
+}
+
+// shadow replays a sampled call against the candidate in the background and records the differences
+// between its answer and primary. The replay is detached from the caller's cancellation.
+func shadow[T any](ctx context.Context, r *ShadowWeatherRepository, op string, primary T, fetch func(ctx context.Context) (T, error), diff func(primary T, candidate T) []fieldDiff) {
+	if !r.sample() {
+		return
+	}
+
+	select {
+	case r.slots <- struct{}{}:
+		r.update(op, func(c *shadowOperationCounters) { c.sampled++ })
+	default:
+		r.update(op, func(c *shadowOperationCounters) { c.sampled++; c.dropped++ })
+		return
+	}
+
+	detached := context.WithoutCancel(ctx)
+	go func() {
+		defer func() { <-r.slots }()
+		defer func() {
+			// A misbehaving candidate must never take the process down with it.
+			if recover() != nil {
+				r.update(op, func(c *shadowOperationCounters) { c.candidateErrors++ })
+			}
+		}()
+
+		shadowCtx, cancel := context.WithTimeout(detached, r.timeout)
+		defer cancel()
+
+		candidate, err := fetch(shadowCtx)
+		if err != nil {
+			r.update(op, func(c *shadowOperationCounters) { c.candidateErrors++ })
+			return
+		}
+		r.record(op, diff(primary, candidate))
+	}()
+}
+
+// update applies fn to the counters of op under the lock.
+func (r *ShadowWeatherRepository) update(op string, fn func(c *shadowOperationCounters)) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	counters, ok := r.stats[op]
+	if !ok {
+		counters = &shadowOperationCounters{fields: make(map[string]*shadowFieldCounters)}
+		r.stats[op] = counters
+	}
+	fn(counters)
+}
+
+// record adds one compared response for op.
+func (r *ShadowWeatherRepository) record(op string, diffs []fieldDiff) {
+	r.update(op, func(c *shadowOperationCounters) {
+		c.compared++
+
+		divergent := false
+		for _, d := range diffs {
+			fc, ok := c.fields[d.field]
+			if !ok {
+				fc = &shadowFieldCounters{}
+				c.fields[d.field] = fc
+			}
+
+			absDiff := math.Abs(d.primary - d.candidate)
+			fc.compared++
+			fc.sumAbsDiff += absDiff
+			fc.maxAbsDiff = math.Max(fc.maxAbsDiff, absDiff)
+			if absDiff > d.tolerance {
+				fc.diverged++
+				divergent = true
+			}
+		}
+		if divergent {
+			c.divergent++
+		}
+	})
+}
+
+func (r *ShadowWeatherRepository) diffWeather(primary *entity.Weather, candidate *entity.Weather) []fieldDiff {
+	return []fieldDiff{
+		{field: "temperature", primary: primary.Temperature, candidate: candidate.Temperature, tolerance: r.tolerances.Temperature},
+		{field: "humidity", primary: float64(primary.Humidity), candidate: float64(candidate.Humidity), tolerance: r.tolerances.Humidity},
+		{field: "wind_speed", primary: primary.WindSpeed, candidate: candidate.WindSpeed, tolerance: r.tolerances.WindSpeed},
+	}
+}
+
+// diffForecast compares the slots both forecasts share by time, plus the number of slots.
+func (r *ShadowWeatherRepository) diffForecast(primary *entity.Forecast, candidate *entity.Forecast) []fieldDiff {
+	diffs := []fieldDiff{
+		{field: "slot_count", primary: float64(len(primary.Slots)), candidate: float64(len(candidate.Slots))},
+	}
+
+	candidateSlots := make(map[int64]entity.ForecastSlot, len(candidate.Slots))
+	for _, slot := range candidate.Slots {
+		candidateSlots[slot.Time.Unix()] = slot
+	}
+	for _, p := range primary.Slots {
+		c, ok := candidateSlots[p.Time.Unix()]
+		if !ok {
+			continue
+		}
+		diffs = append(diffs,
+			fieldDiff{field: "slots.temperature", primary: p.Temperature, candidate: c.Temperature, tolerance: r.tolerances.Temperature},
+			fieldDiff{field: "slots.humidity", primary: float64(p.Humidity), candidate: float64(c.Humidity), tolerance: r.tolerances.Humidity},
+			fieldDiff{field: "slots.wind_speed", primary: p.WindSpeed, candidate: c.WindSpeed, tolerance: r.tolerances.WindSpeed},
+		)
+	}
+	return diffs
+}
+
+// diffAirQuality compares the first reading of each response.
+func (r *ShadowWeatherRepository) diffAirQuality(primary *entity.AirQuality, candidate *entity.AirQuality) []fieldDiff {
+	if len(primary.Readings) == 0 || len(candidate.Readings) == 0 {
+		return nil
+	}
+
+	p, c := primary.Readings[0], candidate.Readings[0]
+	return []fieldDiff{
+		{field: "aqi", primary: float64(p.AQI), candidate: float64(c.AQI), tolerance: r.tolerances.AQI},
+		{field: "pm2_5", primary: p.Components.PM2_5, candidate: c.Components.PM2_5, tolerance: r.tolerances.PM2_5},
+	}
+}
+
+var _ repository.WeatherRepository = (*ShadowWeatherRepository)(nil)
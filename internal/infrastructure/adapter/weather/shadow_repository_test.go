@@ -0,0 +1,177 @@
+package weather
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"weather-api/internal/core/domain/entity"
+	"weather-api/internal/infrastructure/config"
+	"weather-api/internal/infrastructure/support"
+	"weather-api/pkg/language"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testShadowConfig(percent float64) config.ShadowConfig {
+	return config.ShadowConfig{
+		Enabled:       true,
+		Provider:      "candidate",
+		SamplePercent: percent,
+		Timeout:       time.Second,
+		MaxInFlight:   4,
+		Tolerances: config.ShadowTolerances{
+			Temperature: 1,
+			Humidity:    10,
+			WindSpeed:   2,
+		},
+	}
+}
+
+func TestShadowWeatherRepository_RecordsDivergence(t *testing.T) {
+	// Arrange
+	primary := &stubWeatherRepository{
+		weatherFn: func(ctx context.Context, city string) (*entity.Weather, error) {
+			return &entity.Weather{City: city, Temperature: 10, Humidity: 50, WindSpeed: 3}, nil
+		},
+	}
+	candidate := &stubWeatherRepository{
+		weatherFn: func(ctx context.Context, city string) (*entity.Weather, error) {
+			return &entity.Weather{City: city, Temperature: 13, Humidity: 55, WindSpeed: 3.5}, nil
+		},
+	}
+	repo := NewShadowWeatherRepository(primary, candidate, testShadowConfig(100))
+
+	// Act
+	weather, err := repo.GetWeatherByCity(context.Background(), "London", language.English)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 10.0, weather.Temperature)
+	assert.Eventually(t, func() bool {
+		return repo.Stats().Operations[cacheOpCurrent].Compared == 1
+	}, time.Second, 5*time.Millisecond)
+
+	stats := repo.Stats()
+	assert.Equal(t, "candidate", stats.Candidate)
+	current := stats.Operations[cacheOpCurrent]
+	assert.Equal(t, uint64(1), current.Sampled)
+	assert.Equal(t, uint64(1), current.Divergent)
+	assert.Equal(t, uint64(1), current.Fields["temperature"].Diverged)
+	assert.Equal(t, 3.0, current.Fields["temperature"].MaxAbsDiff)
+	assert.Equal(t, uint64(0), current.Fields["humidity"].Diverged)
+	assert.Equal(t, uint64(0), current.Fields["wind_speed"].Diverged)
+}
+
+func TestShadowWeatherRepository_CandidateDoesNotAffectCaller(t *testing.T) {
+	// Arrange
+	release := make(chan struct{})
+	defer close(release)
+	primary := &stubWeatherRepository{}
+	candidate := &stubWeatherRepository{
+		weatherFn: func(ctx context.Context, city string) (*entity.Weather, error) {
+			<-release
+			return nil, support.NewErrUpstream(503, "unavailable")
+		},
+	}
+	repo := NewShadowWeatherRepository(primary, candidate, testShadowConfig(100))
+
+	// Act
+	started := time.Now()
+	weather, err := repo.GetWeatherByCity(context.Background(), "London", language.English)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "London", weather.City)
+	assert.Less(t, time.Since(started), 500*time.Millisecond)
+}
+
+func TestShadowWeatherRepository_CountsCandidateErrors(t *testing.T) {
+	// Arrange
+	primary := &stubWeatherRepository{}
+	candidate := &stubWeatherRepository{
+		forecastFn: func(ctx context.Context, city string) (*entity.Forecast, error) {
+			return nil, support.NewErrNotFound("city not found")
+		},
+	}
+	repo := NewShadowWeatherRepository(primary, candidate, testShadowConfig(100))
+
+	// Act
+	_, err := repo.GetForecastByCity(context.Background(), "London", language.English)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Eventually(t, func() bool {
+		return repo.Stats().Operations[cacheOpForecast].CandidateErrors == 1
+	}, time.Second, 5*time.Millisecond)
+	assert.Equal(t, uint64(0), repo.Stats().Operations[cacheOpForecast].Compared)
+}
+
+func TestShadowWeatherRepository_DropsWhenSaturated(t *testing.T) {
+	// Arrange
+	release := make(chan struct{})
+	defer close(release)
+	primary := &stubWeatherRepository{}
+	candidate := &stubWeatherRepository{
+		weatherFn: func(ctx context.Context, city string) (*entity.Weather, error) {
+			<-release
+			return &entity.Weather{City: city}, nil
+		},
+	}
+	cfg := testShadowConfig(100)
+	cfg.MaxInFlight = 1
+	repo := NewShadowWeatherRepository(primary, candidate, cfg)
+
+	// Act
+	_, _ = repo.GetWeatherByCity(context.Background(), "London", language.English)
+	_, _ = repo.GetWeatherByCity(context.Background(), "Paris", language.English)
+
+	// Assert
+	current := repo.Stats().Operations[cacheOpCurrent]
+	assert.Equal(t, uint64(2), current.Sampled)
+	assert.Equal(t, uint64(1), current.Dropped)
+}
+
+func TestShadowWeatherRepository_SkipsUnsampledAndFailedCalls(t *testing.T) {
+	// Arrange
+	primary := &stubWeatherRepository{
+		airFn: func(ctx context.Context, lat float32, lon float32) (*entity.AirQuality, error) {
+			return nil, support.NewErrUpstream(502, "bad gateway")
+		},
+	}
+	candidate := &stubWeatherRepository{}
+	unsampled := NewShadowWeatherRepository(&stubWeatherRepository{}, candidate, testShadowConfig(0))
+	sampled := NewShadowWeatherRepository(primary, candidate, testShadowConfig(100))
+
+	// Act
+	_, err1 := unsampled.GetWeatherByCity(context.Background(), "London", language.English)
+	_, err2 := sampled.GetAirQuality(context.Background(), 51.5, -0.12)
+
+	// Assert
+	assert.NoError(t, err1)
+	assert.Error(t, err2)
+	assert.Empty(t, unsampled.Stats().Operations)
+	assert.Empty(t, sampled.Stats().Operations)
+	assert.Equal(t, int64(0), candidate.calls.Load())
+}
+
+func TestShadowWeatherRepository_DiffForecastMatchesSlotsByTime(t *testing.T) {
+	// Arrange
+	repo := NewShadowWeatherRepository(nil, nil, testShadowConfig(0))
+	at := time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)
+	primary := &entity.Forecast{Slots: []entity.ForecastSlot{
+		{Time: at, Temperature: 10},
+		{Time: at.Add(3 * time.Hour), Temperature: 11},
+	}}
+	candidate := &entity.Forecast{Slots: []entity.ForecastSlot{
+		{Time: at.Add(3 * time.Hour), Temperature: 14},
+	}}
+
+	// Act
+	diffs := repo.diffForecast(primary, candidate)
+
+	// Assert
+	assert.Len(t, diffs, 4)
+	assert.Equal(t, fieldDiff{field: "slot_count", primary: 2, candidate: 1}, diffs[0])
+	assert.Equal(t, fieldDiff{field: "slots.temperature", primary: 11, candidate: 14, tolerance: 1}, diffs[1])
+}
@@ -0,0 +1,97 @@
+package weather
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"weather-api/internal/core/domain/entity"
+	"weather-api/internal/core/domain/repository"
+	"weather-api/pkg/language"
+	"weather-api/pkg/units"
+)
+
+// stubWeatherRepository is a configurable WeatherRepository for decorator tests.
+// Every call increments calls; unset funcs return simple entities echoing the request.
+type stubWeatherRepository struct {
+	calls atomic.Int64
+
+	weatherFn    func(ctx context.Context, city string) (*entity.Weather, error)
+	overviewFn   func(ctx context.Context, lon float32, lat float32, system units.System) (*entity.WeatherOverview, error)
+	forecastFn   func(ctx context.Context, city string) (*entity.Forecast, error)
+	oneCallFn    func(ctx context.Context, lat float32, lon float32, include []entity.OneCallBlock) (*entity.OneCall, error)
+	airFn        func(ctx context.Context, lat float32, lon float32) (*entity.AirQuality, error)
+	historicalFn func(ctx context.Context, lat float32, lon float32, at time.Time) (*entity.HistoricalWeather, error)
+	daySummaryFn func(ctx context.Context, lat float32, lon float32, date time.Time) (*entity.DaySummary, error)
+}
+
+func (s *stubWeatherRepository) GetWeatherByCity(ctx context.Context, city string, lang language.Code) (*entity.Weather, error) {
+	s.calls.Add(1)
+	if s.weatherFn == nil {
+		return &entity.Weather{City: city, Language: lang}, nil
+	}
+	return s.weatherFn(ctx, city)
+}
+
+func (s *stubWeatherRepository) GetWeatherByCoordinates(ctx context.Context, lat float32, lon float32, lang language.Code) (*entity.Weather, error) {
+	s.calls.Add(1)
+	return &entity.Weather{Language: lang}, nil
+}
+
+func (s *stubWeatherRepository) GetWeatherOverviewByLatLong(ctx context.Context, lon float32, lat float32, system units.System) (*entity.WeatherOverview, error) {
+	s.calls.Add(1)
+	if s.overviewFn == nil {
+		return &entity.WeatherOverview{Lat: lat, Lon: lon}, nil
+	}
+	return s.overviewFn(ctx, lon, lat, system)
+}
+
+func (s *stubWeatherRepository) GetForecastByCity(ctx context.Context, city string, lang language.Code) (*entity.Forecast, error) {
+	s.calls.Add(1)
+	if s.forecastFn == nil {
+		return &entity.Forecast{City: city, Language: lang}, nil
+	}
+	return s.forecastFn(ctx, city)
+}
+
+func (s *stubWeatherRepository) GetOneCall(ctx context.Context, lat float32, lon float32, include []entity.OneCallBlock, lang language.Code) (*entity.OneCall, error) {
+	s.calls.Add(1)
+	if s.oneCallFn == nil {
+		return &entity.OneCall{Lat: lat, Lon: lon, Language: lang}, nil
+	}
+	return s.oneCallFn(ctx, lat, lon, include)
+}
+
+func (s *stubWeatherRepository) GetAirQuality(ctx context.Context, lat float32, lon float32) (*entity.AirQuality, error) {
+	s.calls.Add(1)
+	if s.airFn == nil {
+		return &entity.AirQuality{Lat: lat, Lon: lon}, nil
+	}
+	return s.airFn(ctx, lat, lon)
+}
+
+func (s *stubWeatherRepository) GetAirQualityForecast(ctx context.Context, lat float32, lon float32) (*entity.AirQuality, error) {
+	return s.GetAirQuality(ctx, lat, lon)
+}
+
+func (s *stubWeatherRepository) GetAirQualityHistory(ctx context.Context, lat float32, lon float32, start time.Time, end time.Time) (*entity.AirQuality, error) {
+	return s.GetAirQuality(ctx, lat, lon)
+}
+
+func (s *stubWeatherRepository) GetHistoricalWeather(ctx context.Context, lat float32, lon float32, at time.Time, lang language.Code) (*entity.HistoricalWeather, error) {
+	s.calls.Add(1)
+	if s.historicalFn == nil {
+		return &entity.HistoricalWeather{Lat: lat, Lon: lon, Language: lang}, nil
+	}
+	return s.historicalFn(ctx, lat, lon, at)
+}
+
+func (s *stubWeatherRepository) GetDaySummary(ctx context.Context, lat float32, lon float32, date time.Time) (*entity.DaySummary, error) {
+	s.calls.Add(1)
+	if s.daySummaryFn == nil {
+		return &entity.DaySummary{Lat: lat, Lon: lon, Date: date}, nil
+	}
+	return s.daySummaryFn(ctx, lat, lon, date)
+}
+
+var _ repository.WeatherRepository = (*stubWeatherRepository)(nil)
@@ -0,0 +1,72 @@
+package weather
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestGetWithRetry_TracesEveryAttempt(t *testing.T) {
+	// Arrange
+	recorder := tracetest.NewSpanRecorder()
+	previousProvider, previousPropagator := otel.GetTracerProvider(), otel.GetTextMapPropagator()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	defer func() {
+		otel.SetTracerProvider(previousProvider)
+		otel.SetTextMapPropagator(previousPropagator)
+	}()
+
+	var calls atomic.Int64
+	var traceparents []string
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceparents = append(traceparents, r.Header.Get("traceparent"))
+		if calls.Add(1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer mockServer.Close()
+
+	// Act
+	resp, err := getWithRetry(context.Background(), mockServer.Client(), mockServer.URL+"/data/2.5/weather?q=London&appid=secret", 2, 5*time.Millisecond, 5*time.Millisecond, nil)
+
+	// Assert
+	assert.NoError(t, err)
+	_ = resp.Body.Close()
+	spans := recorder.Ended()
+	if !assert.Len(t, spans, 2) {
+		return
+	}
+
+	first, retried := spans[0], spans[1]
+	assert.Equal(t, "GET /data/2.5/weather", first.Name())
+	assert.Contains(t, first.Attributes(), attribute.Int("retry.attempt", 1))
+	assert.Contains(t, first.Attributes(), attribute.Int64("retry.backoff_ms", 0))
+	assert.Contains(t, first.Attributes(), attribute.Int("http.response.status_code", http.StatusServiceUnavailable))
+	assert.Equal(t, codes.Error, first.Status().Code)
+
+	assert.Contains(t, retried.Attributes(), attribute.Int("retry.attempt", 2))
+	assert.Contains(t, retried.Attributes(), attribute.Int64("retry.backoff_ms", 5))
+	assert.Contains(t, retried.Attributes(), attribute.Int("http.response.status_code", http.StatusOK))
+	assert.Equal(t, codes.Unset, retried.Status().Code)
+
+	for i, span := range spans {
+		assert.Equal(t, "00-"+span.SpanContext().TraceID().String()+"-"+span.SpanContext().SpanID().String()+"-01", traceparents[i], "each attempt propagates its own span")
+		for _, attr := range span.Attributes() {
+			assert.NotContains(t, attr.Value.Emit(), "secret", "the API key must not reach span attributes")
+		}
+	}
+}
@@ -4,18 +4,53 @@ import (
 	"log"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
+	"weather-api/pkg/circuitbreaker"
+	"weather-api/pkg/ratelimit"
+
 	"github.com/joho/godotenv"
 )
 
 // Config holds all configuration for the application
 type Config struct {
-	Server  ServerConfig
-	Weather WeatherConfig
-	Swagger SwaggerConfig
+	Server    ServerConfig
+	Weather   WeatherConfig
+	OpenMeteo OpenMeteoConfig
+	Swagger   SwaggerConfig
+	Cache     CacheConfig
+	Consensus ConsensusConfig
+	Shadow    ShadowConfig
+	Batch     BatchConfig
+	Auth      AuthConfig
+	Admin     AdminConfig
+	RateLimit RateLimitConfig
+	Quota     QuotaConfig
+	Metrics   MetricsConfig
+	Tracing   TracingConfig
+	Health    HealthConfig
 }
 
+// Supported weather providers for WEATHER_PROVIDER
+const (
+	ProviderOpenWeather = "openweather"
+	ProviderOpenMeteo   = "openmeteo"
+)
+
+// Supported span exporters for TRACING_EXPORTER
+const (
+	TracingExporterStdout = "stdout"
+	TracingExporterOTLP   = "otlp"
+)
+
+// Supported blending strategies for CONSENSUS_STRATEGY
+const (
+	ConsensusMedian       = "median"
+	ConsensusWeightedMean = "weighted_mean"
+	ConsensusTrimmedMean  = "trimmed_mean"
+)
+
 // ServerConfig holds server configuration
 type ServerConfig struct {
 	Port         string
@@ -23,16 +58,156 @@ type ServerConfig struct {
 	ReadTimeout  time.Duration
 	WriteTimeout time.Duration
 	IdleTimeout  time.Duration
+	// TrustedProxies lists the proxy IPs/CIDRs whose X-Forwarded-For is believed; with none, the client IP
+	// is the connection's remote address, so clients cannot pick their own rate limit bucket.
+	TrustedProxies []string
 }
 
-// WeatherConfig holds weather API configuration
+// WeatherConfig holds weather API configuration. CircuitBreaker tunes each of the breakers guarding
+// the OpenWeather APIs.
 type WeatherConfig struct {
+	Provider            string
+	FallbackProviders   []string
 	APIKey              string
 	BaseURL             string
 	HTTPTimeout         time.Duration
 	RetryMaxAttempts    int
 	RetryInitialBackoff time.Duration
 	RetryMaxBackoff     time.Duration
+	CircuitBreaker      circuitbreaker.Settings
+}
+
+// OpenMeteoConfig holds Open-Meteo API configuration (no API key required)
+type OpenMeteoConfig struct {
+	ForecastURL         string
+	GeocodingURL        string
+	AirQualityURL       string
+	ArchiveURL          string
+	HTTPTimeout         time.Duration
+	RetryMaxAttempts    int
+	RetryInitialBackoff time.Duration
+	RetryMaxBackoff     time.Duration
+	CircuitBreaker      circuitbreaker.Settings
+}
+
+// CacheConfig holds the in-process weather response cache configuration
+type CacheConfig struct {
+	Enabled       bool
+	MaxEntries    int
+	CurrentTTL    time.Duration
+	OverviewTTL   time.Duration
+	ForecastTTL   time.Duration
+	OneCallTTL    time.Duration
+	AirQualityTTL time.Duration
+	HistoryTTL    time.Duration
+	// StaleTTL keeps entries this long past their TTL so they can still be served when the OpenWeather
+	// call quota is exhausted; zero disables stale answers.
+	StaleTTL time.Duration
+}
+
+// ConsensusConfig holds the multi-provider consensus configuration.
+// Weights apply to the weighted mean strategy; providers without a weight count as 1.
+type ConsensusConfig struct {
+	Enabled  bool
+	Strategy string
+	Timeout  time.Duration
+	Weights  map[string]float64
+}
+
+// ShadowConfig holds the shadow traffic configuration. A sampled share of calls is replayed
+// against the candidate provider and compared field by field within the tolerances.
+type ShadowConfig struct {
+	Enabled       bool
+	Provider      string
+	SamplePercent float64
+	Timeout       time.Duration
+	MaxInFlight   int
+	Tolerances    ShadowTolerances
+}
+
+// ShadowTolerances holds the largest absolute difference per field that still counts as agreement
+type ShadowTolerances struct {
+	Temperature float64
+	Humidity    float64
+	WindSpeed   float64
+	AQI         float64
+	PM2_5       float64
+}
+
+// BatchConfig bounds POST /weather/batch: the largest accepted list and how many items are fetched at once.
+// Streamed (NDJSON) batches do not buffer the response and get their own, larger limit; StreamWriteTimeout
+// replaces the server write timeout for them and is renewed after every line.
+type BatchConfig struct {
+	MaxItems           int
+	Concurrency        int
+	StreamMaxItems     int
+	StreamWriteTimeout time.Duration
+}
+
+// AuthConfig holds API key authentication configuration. When Enabled, every route except the health checks,
+// metrics and Swagger requires a key; KeyFile is the file-backed key store shared with the apikey CLI.
+type AuthConfig struct {
+	Enabled bool
+	KeyFile string
+}
+
+// AdminConfig holds the admin route configuration. With authentication enabled every admin route requires an
+// admin key; without it the admin routes are only mounted when PublicStats is set, and then only the read-only
+// shadow, quota and circuit breaker views, open to every client.
+type AdminConfig struct {
+	PublicStats bool
+}
+
+// RateLimitConfig holds per-client rate limits. Clients are identified by API key, or by IP when
+// unauthenticated. Every request counts against Default (or the client's entry in Keys, by key ID);
+// requests to a route in Routes, by route pattern such as /weather/:city, also count against that
+// route's own per-client limit.
+type RateLimitConfig struct {
+	Enabled    bool
+	MaxClients int
+	Default    ratelimit.Limit
+	Routes     map[string]ratelimit.Limit
+	Keys       map[string]ratelimit.Limit
+}
+
+// QuotaConfig holds the OpenWeather call budgets enforced before each upstream call. OpenWeather bills
+// the 2.5 APIs (current weather, forecast, air pollution and geocoding) and One Call 3.0 separately,
+// so each has its own budget; the counters are shared by every OpenWeather adapter in the process.
+type QuotaConfig struct {
+	Enabled bool
+	Weather QuotaBudget
+	OneCall QuotaBudget
+}
+
+// QuotaBudget is the number of calls allowed per clock minute and per UTC day; zero means unlimited.
+type QuotaBudget struct {
+	PerMinute int
+	PerDay    int
+}
+
+// MetricsConfig holds the Prometheus metrics configuration; Path is served without authentication.
+type MetricsConfig struct {
+	Enabled bool
+	Path    string
+}
+
+// TracingConfig holds the OpenTelemetry tracing configuration. Spans are exported to stdout or, over
+// OTLP/HTTP, to OTLPEndpoint (host:port of a collector); SampleRatio is the share of new traces recorded,
+// while requests arriving with a sampled W3C traceparent are always recorded.
+type TracingConfig struct {
+	Enabled      bool
+	ServiceName  string
+	Exporter     string
+	OTLPEndpoint string
+	OTLPInsecure bool
+	SampleRatio  float64
+}
+
+// HealthConfig holds the readiness check configuration. Every check is bounded by CheckTimeout, and an
+// upstream probe's result is reused for ProbeTTL because each OpenWeather probe counts against the quota.
+type HealthConfig struct {
+	CheckTimeout time.Duration
+	ProbeTTL     time.Duration
 }
 
 // SwaggerConfig holds Swagger related configuration
@@ -49,23 +224,116 @@ func LoadConfig() *Config {
 
 	return &Config{
 		Server: ServerConfig{
-			Port:         getEnv("PORT", "8080"),
-			GinMode:      getEnv("GIN_MODE", "debug"),
-			ReadTimeout:  getEnvDuration("READ_TIMEOUT", "10s"),
-			WriteTimeout: getEnvDuration("WRITE_TIMEOUT", "15s"),
-			IdleTimeout:  getEnvDuration("IDLE_TIMEOUT", "60s"),
+			Port:           getEnv("PORT", "8080"),
+			GinMode:        getEnv("GIN_MODE", "debug"),
+			ReadTimeout:    getEnvDuration("READ_TIMEOUT", "10s"),
+			WriteTimeout:   getEnvDuration("WRITE_TIMEOUT", "15s"),
+			IdleTimeout:    getEnvDuration("IDLE_TIMEOUT", "60s"),
+			TrustedProxies: getEnvList("TRUSTED_PROXIES"),
 		},
 		Weather: WeatherConfig{
+			Provider:            strings.ToLower(getEnv("WEATHER_PROVIDER", ProviderOpenWeather)),
+			FallbackProviders:   getEnvList("WEATHER_FALLBACK_PROVIDERS"),
 			APIKey:              getEnv("OPENWEATHER_API_KEY", ""),
 			BaseURL:             getEnv("OPENWEATHER_BASE_URL", "https://api.openweathermap.org"),
 			HTTPTimeout:         getEnvDuration("OPENWEATHER_HTTP_TIMEOUT", "10s"),
 			RetryMaxAttempts:    getEnvInt("OPENWEATHER_RETRY_MAX_ATTEMPTS", 2),
 			RetryInitialBackoff: getEnvDuration("OPENWEATHER_RETRY_INITIAL_BACKOFF", "200ms"),
 			RetryMaxBackoff:     getEnvDuration("OPENWEATHER_RETRY_MAX_BACKOFF", "2s"),
+			CircuitBreaker:      getEnvBreaker("OPENWEATHER_BREAKER"),
+		},
+		OpenMeteo: OpenMeteoConfig{
+			ForecastURL:         getEnv("OPENMETEO_FORECAST_URL", "https://api.open-meteo.com"),
+			GeocodingURL:        getEnv("OPENMETEO_GEOCODING_URL", "https://geocoding-api.open-meteo.com"),
+			AirQualityURL:       getEnv("OPENMETEO_AIR_QUALITY_URL", "https://air-quality-api.open-meteo.com"),
+			ArchiveURL:          getEnv("OPENMETEO_ARCHIVE_URL", "https://archive-api.open-meteo.com"),
+			HTTPTimeout:         getEnvDuration("OPENMETEO_HTTP_TIMEOUT", "10s"),
+			RetryMaxAttempts:    getEnvInt("OPENMETEO_RETRY_MAX_ATTEMPTS", 2),
+			RetryInitialBackoff: getEnvDuration("OPENMETEO_RETRY_INITIAL_BACKOFF", "200ms"),
+			RetryMaxBackoff:     getEnvDuration("OPENMETEO_RETRY_MAX_BACKOFF", "2s"),
+			CircuitBreaker:      getEnvBreaker("OPENMETEO_BREAKER"),
 		},
 		Swagger: SwaggerConfig{
 			BasePath: getEnv("SWAGGER_BASE_PATH", "/swagger"),
 		},
+		Cache: CacheConfig{
+			Enabled:       getEnvBool("CACHE_ENABLED", true),
+			MaxEntries:    getEnvInt("CACHE_MAX_ENTRIES", 1000),
+			CurrentTTL:    getEnvDuration("CACHE_TTL_CURRENT", "5m"),
+			OverviewTTL:   getEnvDuration("CACHE_TTL_OVERVIEW", "10m"),
+			ForecastTTL:   getEnvDuration("CACHE_TTL_FORECAST", "30m"),
+			OneCallTTL:    getEnvDuration("CACHE_TTL_ONECALL", "5m"),
+			AirQualityTTL: getEnvDuration("CACHE_TTL_AIR_QUALITY", "15m"),
+			HistoryTTL:    getEnvDuration("CACHE_TTL_HISTORY", "24h"),
+			StaleTTL:      getEnvDuration("CACHE_STALE_TTL", "1h"),
+		},
+		Consensus: ConsensusConfig{
+			Enabled:  getEnvBool("CONSENSUS_ENABLED", false),
+			Strategy: strings.ToLower(getEnv("CONSENSUS_STRATEGY", ConsensusMedian)),
+			Timeout:  getEnvDuration("CONSENSUS_TIMEOUT", "3s"),
+			Weights:  getEnvWeights("CONSENSUS_WEIGHTS"),
+		},
+		Shadow: ShadowConfig{
+			Enabled:       getEnvBool("SHADOW_ENABLED", false),
+			Provider:      strings.ToLower(getEnv("SHADOW_PROVIDER", ProviderOpenMeteo)),
+			SamplePercent: getEnvFloat("SHADOW_SAMPLE_PERCENT", 10),
+			Timeout:       getEnvDuration("SHADOW_TIMEOUT", "5s"),
+			MaxInFlight:   getEnvInt("SHADOW_MAX_IN_FLIGHT", 10),
+			Tolerances: ShadowTolerances{
+				Temperature: getEnvFloat("SHADOW_TOLERANCE_TEMPERATURE", 1.5),
+				Humidity:    getEnvFloat("SHADOW_TOLERANCE_HUMIDITY", 10),
+				WindSpeed:   getEnvFloat("SHADOW_TOLERANCE_WIND_SPEED", 2),
+				AQI:         getEnvFloat("SHADOW_TOLERANCE_AQI", 1),
+				PM2_5:       getEnvFloat("SHADOW_TOLERANCE_PM2_5", 5),
+			},
+		},
+		Batch: BatchConfig{
+			MaxItems:           getEnvInt("BATCH_MAX_ITEMS", 50),
+			Concurrency:        getEnvInt("BATCH_CONCURRENCY", 8),
+			StreamMaxItems:     getEnvInt("BATCH_STREAM_MAX_ITEMS", 5000),
+			StreamWriteTimeout: getEnvDuration("BATCH_STREAM_WRITE_TIMEOUT", "30s"),
+		},
+		Auth: AuthConfig{
+			Enabled: getEnvBool("AUTH_ENABLED", false),
+			KeyFile: getEnv("AUTH_KEY_FILE", "data/api_keys.json"),
+		},
+		Admin: AdminConfig{
+			PublicStats: getEnvBool("ADMIN_PUBLIC_STATS", false),
+		},
+		RateLimit: RateLimitConfig{
+			Enabled:    getEnvBool("RATE_LIMIT_ENABLED", false),
+			MaxClients: getEnvInt("RATE_LIMIT_MAX_CLIENTS", 100000),
+			Default:    getEnvLimit("RATE_LIMIT_DEFAULT", "60/1m"),
+			Routes:     getEnvLimits("RATE_LIMIT_ROUTES"),
+			Keys:       getEnvLimits("RATE_LIMIT_KEYS"),
+		},
+		Quota: QuotaConfig{
+			Enabled: getEnvBool("OPENWEATHER_QUOTA_ENABLED", false),
+			Weather: QuotaBudget{
+				PerMinute: getEnvInt("OPENWEATHER_QUOTA_WEATHER_PER_MINUTE", 60),
+				PerDay:    getEnvInt("OPENWEATHER_QUOTA_WEATHER_PER_DAY", 0),
+			},
+			OneCall: QuotaBudget{
+				PerMinute: getEnvInt("OPENWEATHER_QUOTA_ONECALL_PER_MINUTE", 0),
+				PerDay:    getEnvInt("OPENWEATHER_QUOTA_ONECALL_PER_DAY", 1000),
+			},
+		},
+		Metrics: MetricsConfig{
+			Enabled: getEnvBool("METRICS_ENABLED", true),
+			Path:    getEnv("METRICS_PATH", "/metrics"),
+		},
+		Tracing: TracingConfig{
+			Enabled:      getEnvBool("TRACING_ENABLED", false),
+			ServiceName:  getEnv("TRACING_SERVICE_NAME", "weather-api"),
+			Exporter:     strings.ToLower(getEnv("TRACING_EXPORTER", TracingExporterStdout)),
+			OTLPEndpoint: getEnv("TRACING_OTLP_ENDPOINT", "localhost:4318"),
+			OTLPInsecure: getEnvBool("TRACING_OTLP_INSECURE", true),
+			SampleRatio:  getEnvFloat("TRACING_SAMPLE_RATIO", 1),
+		},
+		Health: HealthConfig{
+			CheckTimeout: getEnvDuration("HEALTH_CHECK_TIMEOUT", "5s"),
+			ProbeTTL:     getEnvDuration("HEALTH_PROBE_TTL", "5m"),
+		},
 	}
 }
 
@@ -99,3 +367,90 @@ func getEnvInt(key string, fallback int) int {
 	}
 	return fallback
 }
+
+// getEnvFloat gets a float64 from env with fallback
+func getEnvFloat(key string, fallback float64) float64 {
+	if value := os.Getenv(key); value != "" {
+		if f, err := strconv.ParseFloat(value, 64); err == nil {
+			return f
+		}
+		log.Printf("invalid float for %s=%q, using fallback %g", key, value, fallback)
+	}
+	return fallback
+}
+
+// getEnvBool gets a bool from env with fallback
+func getEnvBool(key string, fallback bool) bool {
+	if value := os.Getenv(key); value != "" {
+		if b, err := strconv.ParseBool(value); err == nil {
+			return b
+		}
+		log.Printf("invalid bool for %s=%q, using fallback %t", key, value, fallback)
+	}
+	return fallback
+}
+
+// getEnvList gets a comma separated, lowercased list from env; blank items are dropped
+func getEnvList(key string) []string {
+	var items []string
+	for _, item := range strings.Split(os.Getenv(key), ",") {
+		if item = strings.ToLower(strings.TrimSpace(item)); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// getEnvWeights gets a comma separated list of name:weight pairs from env; invalid pairs are skipped
+func getEnvWeights(key string) map[string]float64 {
+	weights := make(map[string]float64)
+	for _, item := range getEnvList(key) {
+		name, value, ok := strings.Cut(item, ":")
+		weight, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if !ok || err != nil || weight < 0 {
+			log.Printf("invalid weight %q in %s, skipping", item, key)
+			continue
+		}
+		weights[strings.TrimSpace(name)] = weight
+	}
+	return weights
+}
+
+// getEnvBreaker reads circuit breaker settings from prefix_MAX_REQUESTS, prefix_INTERVAL, prefix_TIMEOUT,
+// prefix_MIN_REQUESTS and prefix_FAILURE_RATIO, falling back to circuitbreaker.DefaultSettings.
+func getEnvBreaker(prefix string) circuitbreaker.Settings {
+	defaults := circuitbreaker.DefaultSettings()
+	return circuitbreaker.Settings{
+		MaxRequests:  uint32(max(getEnvInt(prefix+"_MAX_REQUESTS", int(defaults.MaxRequests)), 1)),
+		Interval:     getEnvDuration(prefix+"_INTERVAL", defaults.Interval.String()),
+		Timeout:      getEnvDuration(prefix+"_TIMEOUT", defaults.Timeout.String()),
+		MinRequests:  uint32(max(getEnvInt(prefix+"_MIN_REQUESTS", int(defaults.MinRequests)), 1)),
+		FailureRatio: getEnvFloat(prefix+"_FAILURE_RATIO", defaults.FailureRatio),
+	}
+}
+
+// getEnvLimit gets a rate limit such as "60/1m" from env with fallback
+func getEnvLimit(key, fallback string) ratelimit.Limit {
+	value := getEnv(key, fallback)
+	limit, err := ratelimit.ParseLimit(value)
+	if err != nil {
+		log.Printf("invalid rate limit for %s=%q, using fallback %s: %v", key, value, fallback, err)
+		limit, _ = ratelimit.ParseLimit(fallback)
+	}
+	return limit
+}
+
+// getEnvLimits gets a comma separated list of name=limit pairs from env; invalid pairs are skipped
+func getEnvLimits(key string) map[string]ratelimit.Limit {
+	limits := make(map[string]ratelimit.Limit)
+	for _, item := range getEnvList(key) {
+		name, value, ok := strings.Cut(item, "=")
+		limit, err := ratelimit.ParseLimit(value)
+		if !ok || err != nil {
+			log.Printf("invalid rate limit %q in %s, skipping", item, key)
+			continue
+		}
+		limits[strings.TrimSpace(name)] = limit
+	}
+	return limits
+}
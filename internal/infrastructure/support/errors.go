@@ -1,10 +1,16 @@
 package support
 
-import "fmt"
+import (
+	"fmt"
+	"time"
+
+	"weather-api/pkg/circuitbreaker"
+)
 
 // ErrNotFound is a custom error type used when a resource is not found.
 // This allows handlers to distinguish between a generic error and a "not found" condition,
-// enabling them to return a 404 HTTP status code.
+// enabling them to return a 404 HTTP status code. The upstream answered correctly, so it matches
+// circuitbreaker.ErrCallerError and does not count against the breaker.
 type ErrNotFound struct {
 	Message string
 }
@@ -13,17 +19,21 @@ func (e *ErrNotFound) Error() string {
 	return e.Message
 }
 
+func (e *ErrNotFound) Is(target error) bool { return target == circuitbreaker.ErrCallerError }
+
 // NewErrNotFound creates a new ErrNotFound error.
 func NewErrNotFound(message string) *ErrNotFound {
 	return &ErrNotFound{Message: message}
 }
 
-// ErrBadRequest represents validation or client input errors (HTTP 400).
+// ErrBadRequest represents validation or client input errors (HTTP 400). Like ErrNotFound it matches
+// circuitbreaker.ErrCallerError.
 type ErrBadRequest struct {
 	Message string
 }
 
 func (e *ErrBadRequest) Error() string               { return e.Message }
+func (e *ErrBadRequest) Is(target error) bool        { return target == circuitbreaker.ErrCallerError }
 func NewErrBadRequest(message string) *ErrBadRequest { return &ErrBadRequest{Message: message} }
 
 // ErrUnauthorized represents authentication failures (HTTP 401).
@@ -38,6 +48,14 @@ type ErrForbidden struct{ Message string }
 func (e *ErrForbidden) Error() string              { return e.Message }
 func NewErrForbidden(message string) *ErrForbidden { return &ErrForbidden{Message: message} }
 
+// ErrTooManyRequests represents a client exceeding its rate limit (HTTP 429).
+type ErrTooManyRequests struct{ Message string }
+
+func (e *ErrTooManyRequests) Error() string { return e.Message }
+func NewErrTooManyRequests(message string) *ErrTooManyRequests {
+	return &ErrTooManyRequests{Message: message}
+}
+
 // ErrTimeout represents request timeout to upstream or internal operations (HTTP 504 suggested).
 type ErrTimeout struct{ Message string }
 
@@ -56,3 +74,48 @@ func (e *ErrUpstream) Error() string {
 func NewErrUpstream(status int, body string) *ErrUpstream {
 	return &ErrUpstream{StatusCode: status, Body: body}
 }
+
+// ErrUpstreamUnauthorized represents the upstream rejecting the service's own credentials, such as a
+// wrong API key or one without a subscription to the API called (HTTP 502 suggested, since the client
+// is not at fault). It counts against the breaker: every call fails the same way until the key is fixed.
+type ErrUpstreamUnauthorized struct{ Message string }
+
+func (e *ErrUpstreamUnauthorized) Error() string { return e.Message }
+func NewErrUpstreamUnauthorized(message string) *ErrUpstreamUnauthorized {
+	return &ErrUpstreamUnauthorized{Message: message}
+}
+
+// ErrUpstreamRateLimited represents the upstream throttling the service (HTTP 503 suggested). RetryAfter
+// is the upstream's Retry-After, or zero when it sent none. It counts against the breaker, so that a
+// throttled upstream is given a rest instead of more calls.
+type ErrUpstreamRateLimited struct {
+	Message    string
+	RetryAfter time.Duration
+}
+
+func (e *ErrUpstreamRateLimited) Error() string { return e.Message }
+func NewErrUpstreamRateLimited(message string, retryAfter time.Duration) *ErrUpstreamRateLimited {
+	return &ErrUpstreamRateLimited{Message: message, RetryAfter: retryAfter}
+}
+
+// ErrQuotaExceeded represents an upstream call refused because its call budget is used up (HTTP 503).
+// RetryAfter is the time until the exhausted budget resets. The call never left the process, so it
+// matches circuitbreaker.ErrNotAttempted and does not count against the breaker.
+type ErrQuotaExceeded struct {
+	Message    string
+	RetryAfter time.Duration
+}
+
+func (e *ErrQuotaExceeded) Error() string        { return e.Message }
+func (e *ErrQuotaExceeded) Is(target error) bool { return target == circuitbreaker.ErrNotAttempted }
+func NewErrQuotaExceeded(message string, retryAfter time.Duration) *ErrQuotaExceeded {
+	return &ErrQuotaExceeded{Message: message, RetryAfter: retryAfter}
+}
+
+// ErrNotImplemented represents operations the active provider does not support (HTTP 501).
+type ErrNotImplemented struct{ Message string }
+
+func (e *ErrNotImplemented) Error() string { return e.Message }
+func NewErrNotImplemented(message string) *ErrNotImplemented {
+	return &ErrNotImplemented{Message: message}
+}
@@ -0,0 +1,91 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"weather-api/internal/core/domain/entity"
+	"weather-api/internal/core/service"
+	"weather-api/internal/infrastructure/config"
+	"weather-api/pkg/language"
+	"weather-api/pkg/units"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+// stubWeatherService implements only the methods a test calls; the others panic on the nil interface.
+type stubWeatherService struct {
+	service.WeatherServiceInterface
+	err error
+}
+
+func (s *stubWeatherService) GetWeatherByCity(ctx context.Context, city string, system units.System, lang language.Code) (*entity.Weather, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &entity.Weather{City: city}, nil
+}
+
+func recordSpans(t *testing.T) *tracetest.SpanRecorder {
+	recorder := tracetest.NewSpanRecorder()
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+	return recorder
+}
+
+func TestWeatherService_RecordsSpan(t *testing.T) {
+	// Arrange
+	recorder := recordSpans(t)
+	traced := NewWeatherService(&stubWeatherService{})
+
+	// Act
+	weather, err := traced.GetWeatherByCity(context.Background(), "London", units.Imperial, language.Code("de"))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "London", weather.City)
+	spans := recorder.Ended()
+	if assert.Len(t, spans, 1) {
+		assert.Equal(t, "WeatherService.GetWeatherByCity", spans[0].Name())
+		assert.Contains(t, spans[0].Attributes(), attribute.String("weather.city", "London"))
+		assert.Contains(t, spans[0].Attributes(), attribute.String("weather.units", "imperial"))
+		assert.Contains(t, spans[0].Attributes(), attribute.String("weather.lang", "de"))
+		assert.Equal(t, codes.Unset, spans[0].Status().Code)
+	}
+}
+
+func TestWeatherService_RecordsError(t *testing.T) {
+	// Arrange
+	recorder := recordSpans(t)
+	traced := NewWeatherService(&stubWeatherService{err: errors.New("upstream down")})
+
+	// Act
+	_, err := traced.GetWeatherByCity(context.Background(), "London", units.Metric, language.English)
+
+	// Assert
+	assert.EqualError(t, err, "upstream down")
+	spans := recorder.Ended()
+	if assert.Len(t, spans, 1) {
+		assert.Equal(t, codes.Error, spans[0].Status().Code)
+		assert.Equal(t, "upstream down", spans[0].Status().Description)
+		assert.Len(t, spans[0].Events(), 1, "the error is recorded as an exception event")
+	}
+}
+
+func TestSetup(t *testing.T) {
+	// Act
+	shutdown, disabledErr := Setup(context.Background(), config.TracingConfig{Enabled: false})
+	_, unsupportedErr := Setup(context.Background(), config.TracingConfig{Enabled: true, Exporter: "zipkin"})
+
+	// Assert
+	assert.NoError(t, disabledErr)
+	assert.NoError(t, shutdown(context.Background()))
+	assert.EqualError(t, unsupportedErr, `unsupported tracing exporter "zipkin" (expected "stdout" or "otlp")`)
+}
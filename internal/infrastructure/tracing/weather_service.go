@@ -0,0 +1,130 @@
+package tracing
+
+import (
+	"context"
+	"time"
+
+	"weather-api/internal/core/domain/entity"
+	"weather-api/internal/core/service"
+	"weather-api/pkg/language"
+	"weather-api/pkg/units"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+const instrumentationName = "weather-api/internal/infrastructure/tracing"
+
+// WeatherService decorates a WeatherServiceInterface with one span per call, named after the method
+// and carrying its arguments as attributes. Failed calls record the error on the span.
+type WeatherService struct {
+	next service.WeatherServiceInterface
+}
+
+// NewWeatherService wraps next with tracing.
+func NewWeatherService(next service.WeatherServiceInterface) *WeatherService {
+	return &WeatherService{next: next}
+}
+
+func (s *WeatherService) GetWeatherByCity(ctx context.Context, city string, system units.System, lang language.Code) (*entity.Weather, error) {
+	return traced(ctx, "WeatherService.GetWeatherByCity", func(ctx context.Context) (*entity.Weather, error) {
+		return s.next.GetWeatherByCity(ctx, city, system, lang)
+	}, attribute.String("weather.city", city), unitsAttr(system), languageAttr(lang))
+}
+
+func (s *WeatherService) GetWeatherByCoordinates(ctx context.Context, lat float32, lon float32, system units.System, lang language.Code) (*entity.Weather, error) {
+	return traced(ctx, "WeatherService.GetWeatherByCoordinates", func(ctx context.Context) (*entity.Weather, error) {
+		return s.next.GetWeatherByCoordinates(ctx, lat, lon, system, lang)
+	}, append(coordAttrs(lat, lon), unitsAttr(system), languageAttr(lang))...)
+}
+
+func (s *WeatherService) GetWeatherOverviewByLatLong(ctx context.Context, lon float32, lat float32, system units.System) (*entity.WeatherOverview, error) {
+	return traced(ctx, "WeatherService.GetWeatherOverviewByLatLong", func(ctx context.Context) (*entity.WeatherOverview, error) {
+		return s.next.GetWeatherOverviewByLatLong(ctx, lon, lat, system)
+	}, append(coordAttrs(lat, lon), unitsAttr(system))...)
+}
+
+func (s *WeatherService) GetForecastByCity(ctx context.Context, city string, system units.System, lang language.Code) (*entity.Forecast, error) {
+	return traced(ctx, "WeatherService.GetForecastByCity", func(ctx context.Context) (*entity.Forecast, error) {
+		return s.next.GetForecastByCity(ctx, city, system, lang)
+	}, attribute.String("weather.city", city), unitsAttr(system), languageAttr(lang))
+}
+
+func (s *WeatherService) GetOneCall(ctx context.Context, lat float32, lon float32, include []entity.OneCallBlock, system units.System, lang language.Code) (*entity.OneCall, error) {
+	blocks := make([]string, len(include))
+	for i, block := range include {
+		blocks[i] = string(block)
+	}
+	return traced(ctx, "WeatherService.GetOneCall", func(ctx context.Context) (*entity.OneCall, error) {
+		return s.next.GetOneCall(ctx, lat, lon, include, system, lang)
+	}, append(coordAttrs(lat, lon), attribute.StringSlice("weather.include", blocks), unitsAttr(system), languageAttr(lang))...)
+}
+
+func (s *WeatherService) GetAirQuality(ctx context.Context, lat float32, lon float32) (*entity.AirQuality, error) {
+	return traced(ctx, "WeatherService.GetAirQuality", func(ctx context.Context) (*entity.AirQuality, error) {
+		return s.next.GetAirQuality(ctx, lat, lon)
+	}, coordAttrs(lat, lon)...)
+}
+
+func (s *WeatherService) GetAirQualityForecast(ctx context.Context, lat float32, lon float32) (*entity.AirQuality, error) {
+	return traced(ctx, "WeatherService.GetAirQualityForecast", func(ctx context.Context) (*entity.AirQuality, error) {
+		return s.next.GetAirQualityForecast(ctx, lat, lon)
+	}, coordAttrs(lat, lon)...)
+}
+
+func (s *WeatherService) GetAirQualityHistory(ctx context.Context, lat float32, lon float32, start time.Time, end time.Time) (*entity.AirQuality, error) {
+	return traced(ctx, "WeatherService.GetAirQualityHistory", func(ctx context.Context) (*entity.AirQuality, error) {
+		return s.next.GetAirQualityHistory(ctx, lat, lon, start, end)
+	}, append(coordAttrs(lat, lon), timeAttr("weather.start", start), timeAttr("weather.end", end))...)
+}
+
+func (s *WeatherService) GetHistoricalWeather(ctx context.Context, lat float32, lon float32, at time.Time, system units.System, lang language.Code) (*entity.HistoricalWeather, error) {
+	return traced(ctx, "WeatherService.GetHistoricalWeather", func(ctx context.Context) (*entity.HistoricalWeather, error) {
+		return s.next.GetHistoricalWeather(ctx, lat, lon, at, system, lang)
+	}, append(coordAttrs(lat, lon), timeAttr("weather.at", at), unitsAttr(system), languageAttr(lang))...)
+}
+
+func (s *WeatherService) GetHistoricalRange(ctx context.Context, lat float32, lon float32, from time.Time, to time.Time, system units.System) (*entity.HistoricalRange, error) {
+	return traced(ctx, "WeatherService.GetHistoricalRange", func(ctx context.Context) (*entity.HistoricalRange, error) {
+		return s.next.GetHistoricalRange(ctx, lat, lon, from, to, system)
+	}, append(coordAttrs(lat, lon), timeAttr("weather.from", from), timeAttr("weather.to", to), unitsAttr(system))...)
+}
+
+func (s *WeatherService) GetAlerts(ctx context.Context, lat float32, lon float32, minSeverity entity.AlertSeverity, activeAt time.Time) ([]entity.WeatherAlert, error) {
+	return traced(ctx, "WeatherService.GetAlerts", func(ctx context.Context) ([]entity.WeatherAlert, error) {
+		return s.next.GetAlerts(ctx, lat, lon, minSeverity, activeAt)
+	}, append(coordAttrs(lat, lon), attribute.String("weather.min_severity", minSeverity.String()), timeAttr("weather.active_at", activeAt))...)
+}
+
+// traced runs fn inside a new span and records a returned error on it.
+func traced[T any](ctx context.Context, name string, fn func(ctx context.Context) (T, error), attrs ...attribute.KeyValue) (T, error) {
+	ctx, span := otel.Tracer(instrumentationName).Start(ctx, name)
+	defer span.End()
+	span.SetAttributes(attrs...)
+
+	result, err := fn(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	return result, err
+}
+
+func coordAttrs(lat float32, lon float32) []attribute.KeyValue {
+	return []attribute.KeyValue{attribute.Float64("weather.lat", float64(lat)), attribute.Float64("weather.lon", float64(lon))}
+}
+
+func unitsAttr(system units.System) attribute.KeyValue {
+	return attribute.String("weather.units", string(system.OrMetric()))
+}
+
+func languageAttr(lang language.Code) attribute.KeyValue {
+	return attribute.String("weather.lang", string(lang.OrDefault()))
+}
+
+func timeAttr(key string, t time.Time) attribute.KeyValue {
+	return attribute.String(key, t.UTC().Format(time.RFC3339))
+}
+
+var _ service.WeatherServiceInterface = (*WeatherService)(nil)
@@ -0,0 +1,88 @@
+package handler
+
+import (
+	"net/http"
+
+	"weather-api/internal/core/domain/entity"
+	"weather-api/internal/dto"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ShadowStatsSource reports the divergence statistics collected in shadow mode.
+type ShadowStatsSource interface {
+	Stats() entity.ShadowStats
+}
+
+// QuotaUsageSource reports the upstream calls counted against each budgeted endpoint.
+type QuotaUsageSource interface {
+	Usage() []entity.QuotaUsage
+}
+
+// CircuitBreakerSource reports the upstream circuit breakers and their counts.
+type CircuitBreakerSource interface {
+	Statuses() []entity.CircuitBreakerStatus
+}
+
+// AdminHandler handles HTTP requests for operational endpoints.
+type AdminHandler struct {
+	shadow   ShadowStatsSource
+	quota    QuotaUsageSource
+	breakers CircuitBreakerSource
+}
+
+// NewAdminHandler creates a new admin handler. shadow may be nil when shadow mode is disabled and
+// quota may be nil when no upstream quota is enforced.
+func NewAdminHandler(shadow ShadowStatsSource, quota QuotaUsageSource, breakers CircuitBreakerSource) *AdminHandler {
+	return &AdminHandler{
+		shadow:   shadow,
+		quota:    quota,
+		breakers: breakers,
+	}
+}
+
+// GetShadowStats godoc
+// @Summary      Get shadow traffic statistics
+// @Description  Reports how often the shadow candidate provider's answers diverged from the primary provider, per operation and field.
+// @Tags         Admin
+// @Produce      json
+// @Success      200  {object}  dto.ShadowStatsResponse  "Shadow statistics; enabled is false when shadow mode is off"
+// @Security     ApiKeyAuth
+// @Router       /admin/shadow [get]
+func (h *AdminHandler) GetShadowStats(c *gin.Context) {
+	if h.shadow == nil {
+		c.JSON(http.StatusOK, dto.ShadowStatsResponse{Success: true, Data: &dto.ShadowStatsData{Enabled: false}})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ShadowStatsResponse{Success: true, Data: toShadowStatsData(h.shadow.Stats())})
+}
+
+// GetQuotaUsage godoc
+// @Summary      Get upstream quota usage
+// @Description  Reports the OpenWeather calls made in the current minute and UTC day against each endpoint's budget (2.5 weather APIs and One Call 3.0), and how many calls were refused because a budget was used up.
+// @Tags         Admin
+// @Produce      json
+// @Success      200  {object}  dto.QuotaUsageResponse  "Quota usage; enabled is false when no quota is enforced"
+// @Security     ApiKeyAuth
+// @Router       /admin/quota [get]
+func (h *AdminHandler) GetQuotaUsage(c *gin.Context) {
+	if h.quota == nil {
+		c.JSON(http.StatusOK, dto.QuotaUsageResponse{Success: true, Data: &dto.QuotaUsageData{Enabled: false}})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.QuotaUsageResponse{Success: true, Data: toQuotaUsageData(h.quota.Usage())})
+}
+
+// GetCircuitBreakers godoc
+// @Summary      Get circuit breaker states
+// @Description  Reports the state of every upstream circuit breaker, the requests, successes and failures counted in its current window, and how many calls it has rejected.
+// @Tags         Admin
+// @Produce      json
+// @Success      200  {object}  dto.CircuitBreakersResponse  "Circuit breaker states"
+// @Security     ApiKeyAuth
+// @Router       /admin/breakers [get]
+func (h *AdminHandler) GetCircuitBreakers(c *gin.Context) {
+	c.JSON(http.StatusOK, dto.CircuitBreakersResponse{Success: true, Data: toCircuitBreakersData(h.breakers.Statuses())})
+}
@@ -0,0 +1,167 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"weather-api/internal/core/domain/entity"
+	"weather-api/internal/dto"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+type stubShadowStats struct {
+	stats entity.ShadowStats
+}
+
+func (s *stubShadowStats) Stats() entity.ShadowStats {
+	return s.stats
+}
+
+type stubQuotaUsage struct {
+	usage []entity.QuotaUsage
+}
+
+func (s *stubQuotaUsage) Usage() []entity.QuotaUsage {
+	return s.usage
+}
+
+type stubCircuitBreakers struct {
+	statuses []entity.CircuitBreakerStatus
+}
+
+func (s *stubCircuitBreakers) Statuses() []entity.CircuitBreakerStatus {
+	return s.statuses
+}
+
+func TestAdminHandler_GetShadowStats(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	handler := NewAdminHandler(&stubShadowStats{stats: entity.ShadowStats{
+		Candidate:     "openmeteo",
+		SamplePercent: 10,
+		Operations: map[string]entity.ShadowOperationStats{
+			"current": {
+				Sampled:   5,
+				Compared:  4,
+				Divergent: 1,
+				Fields: map[string]entity.ShadowFieldStats{
+					"temperature": {Compared: 4, Diverged: 1, MeanAbsDiff: 0.9, MaxAbsDiff: 2.4},
+				},
+			},
+		},
+	}}, nil, nil)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/admin/shadow", nil)
+
+	// Act
+	handler.GetShadowStats(c)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response dto.ShadowStatsResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.True(t, response.Data.Enabled)
+	assert.Equal(t, "openmeteo", response.Data.Candidate)
+	assert.Equal(t, uint64(1), response.Data.Operations["current"].Divergent)
+	assert.Equal(t, 2.4, response.Data.Operations["current"].Fields["temperature"].MaxAbsDiff)
+}
+
+func TestAdminHandler_GetShadowStats_Disabled(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	handler := NewAdminHandler(nil, nil, nil)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/admin/shadow", nil)
+
+	// Act
+	handler.GetShadowStats(c)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"success": true, "data": {"enabled": false}}`, w.Body.String())
+}
+
+func TestAdminHandler_GetQuotaUsage(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	reset := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	handler := NewAdminHandler(nil, &stubQuotaUsage{usage: []entity.QuotaUsage{
+		{
+			Endpoint: "3.0",
+			Minute:   entity.QuotaWindow{Used: 2, ResetAt: reset},
+			Day:      entity.QuotaWindow{Used: 1000, Limit: 1000, ResetAt: reset},
+			Rejected: 3,
+		},
+	}}, nil)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/admin/quota", nil)
+
+	// Act
+	handler.GetQuotaUsage(c)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"success": true, "data": {"enabled": true, "endpoints": [{
+		"endpoint": "3.0",
+		"minute": {"used": 2, "reset_at": "2024-01-02T00:00:00Z"},
+		"day": {"used": 1000, "limit": 1000, "remaining": 0, "reset_at": "2024-01-02T00:00:00Z"},
+		"rejected": 3
+	}]}}`, w.Body.String())
+}
+
+func TestAdminHandler_GetQuotaUsage_Disabled(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	handler := NewAdminHandler(nil, nil, nil)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/admin/quota", nil)
+
+	// Act
+	handler.GetQuotaUsage(c)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"success": true, "data": {"enabled": false}}`, w.Body.String())
+}
+
+func TestAdminHandler_GetCircuitBreakers(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	handler := NewAdminHandler(nil, nil, &stubCircuitBreakers{statuses: []entity.CircuitBreakerStatus{
+		{Name: "openweather-onecall", State: "open", Requests: 4, Successes: 1, Failures: 3, ConsecutiveFailures: 3, Rejected: 12},
+	}})
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/admin/breakers", nil)
+
+	// Act
+	handler.GetCircuitBreakers(c)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"success": true, "data": [{
+		"name": "openweather-onecall",
+		"state": "open",
+		"requests": 4,
+		"successes": 1,
+		"failures": 3,
+		"consecutive_failures": 3,
+		"rejected": 12
+	}]}`, w.Body.String())
+}
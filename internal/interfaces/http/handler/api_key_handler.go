@@ -0,0 +1,124 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"weather-api/internal/core/domain/entity"
+	"weather-api/internal/core/domain/repository"
+	"weather-api/internal/core/service"
+	"weather-api/internal/dto"
+	"weather-api/internal/infrastructure/support"
+
+	"github.com/gin-gonic/gin"
+)
+
+// APIKeyHandler handles HTTP requests for API key management.
+type APIKeyHandler struct {
+	apiKeyService service.APIKeyServiceInterface
+}
+
+// NewAPIKeyHandler creates a new API key handler.
+func NewAPIKeyHandler(apiKeyService service.APIKeyServiceInterface) *APIKeyHandler {
+	return &APIKeyHandler{
+		apiKeyService: apiKeyService,
+	}
+}
+
+// CreateAPIKey godoc
+// @Summary      Create an API key
+// @Description  Creates an API key with the given scopes (weather:read, admin) and optional expiry. The secret is returned in `key` only in this response; only its hash is stored.
+// @Tags         Admin
+// @Accept       json
+// @Produce      json
+// @Param        request  body      dto.CreateAPIKeyRequest  true  "Key name, scopes and optional expiry"
+// @Success      201  {object}  dto.CreatedAPIKeyResponse  "Key created"
+// @Failure      400  {object}  dto.CreatedAPIKeyResponse  "Invalid name, scope or expiry"
+// @Failure      401  {object}  dto.CreatedAPIKeyResponse  "Missing or invalid API key"
+// @Failure      403  {object}  dto.CreatedAPIKeyResponse  "API key lacks the admin scope"
+// @Failure      500  {object}  dto.CreatedAPIKeyResponse  "Internal server error"
+// @Security     ApiKeyAuth
+// @Router       /admin/keys [post]
+func (h *APIKeyHandler) CreateAPIKey(c *gin.Context) {
+	var request dto.CreateAPIKeyRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		writeError(c, support.NewErrBadRequest(err.Error()))
+		return
+	}
+
+	scopes := make([]entity.APIKeyScope, len(request.Scopes))
+	for i, scope := range request.Scopes {
+		scopes[i] = entity.APIKeyScope(scope)
+	}
+
+	key, secret, err := h.apiKeyService.CreateAPIKey(c.Request.Context(), request.Name, scopes, request.ExpiresAt)
+	if errors.Is(err, service.ErrInvalidAPIKeyScope) || errors.Is(err, service.ErrInvalidAPIKeyExpiry) {
+		writeError(c, support.NewErrBadRequest(err.Error()))
+		return
+	}
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.CreatedAPIKeyResponse{
+		Success: true,
+		Data:    &dto.CreatedAPIKeyData{APIKeyData: toAPIKeyData(*key, time.Now()), Key: secret},
+	})
+}
+
+// ListAPIKeys godoc
+// @Summary      List API keys
+// @Description  Lists every API key, including expired and revoked ones. Secrets and hashes are never returned.
+// @Tags         Admin
+// @Produce      json
+// @Success      200  {object}  dto.APIKeyListResponse  "All keys"
+// @Failure      401  {object}  dto.APIKeyListResponse  "Missing or invalid API key"
+// @Failure      403  {object}  dto.APIKeyListResponse  "API key lacks the admin scope"
+// @Failure      500  {object}  dto.APIKeyListResponse  "Internal server error"
+// @Security     ApiKeyAuth
+// @Router       /admin/keys [get]
+func (h *APIKeyHandler) ListAPIKeys(c *gin.Context) {
+	keys, err := h.apiKeyService.ListAPIKeys(c.Request.Context())
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	now := time.Now()
+	data := make([]dto.APIKeyData, len(keys))
+	for i, key := range keys {
+		data[i] = toAPIKeyData(key, now)
+	}
+
+	c.JSON(http.StatusOK, dto.APIKeyListResponse{Success: true, Data: data})
+}
+
+// RevokeAPIKey godoc
+// @Summary      Revoke an API key
+// @Description  Revokes an API key immediately. The key stays listed with its revocation time; revoking it again is a no-op.
+// @Tags         Admin
+// @Produce      json
+// @Param        id   path      string  true  "API key ID"
+// @Success      200  {object}  dto.APIKeyResponse  "Key revoked"
+// @Failure      401  {object}  dto.APIKeyResponse  "Missing or invalid API key"
+// @Failure      403  {object}  dto.APIKeyResponse  "API key lacks the admin scope"
+// @Failure      404  {object}  dto.APIKeyResponse  "No key with this ID"
+// @Failure      500  {object}  dto.APIKeyResponse  "Internal server error"
+// @Security     ApiKeyAuth
+// @Router       /admin/keys/{id} [delete]
+func (h *APIKeyHandler) RevokeAPIKey(c *gin.Context) {
+	key, err := h.apiKeyService.RevokeAPIKey(c.Request.Context(), c.Param("id"))
+	if errors.Is(err, repository.ErrAPIKeyNotFound) {
+		writeError(c, support.NewErrNotFound(err.Error()))
+		return
+	}
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	data := toAPIKeyData(*key, time.Now())
+	c.JSON(http.StatusOK, dto.APIKeyResponse{Success: true, Data: &data})
+}
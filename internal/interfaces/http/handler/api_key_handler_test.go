@@ -0,0 +1,193 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"weather-api/internal/core/domain/entity"
+	"weather-api/internal/core/domain/repository"
+	"weather-api/internal/core/service"
+	"weather-api/internal/dto"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockAPIKeyService is a mock implementation for testing
+type MockAPIKeyService struct {
+	mock.Mock
+}
+
+func (m *MockAPIKeyService) CreateAPIKey(ctx context.Context, name string, scopes []entity.APIKeyScope, expiresAt *time.Time) (*entity.APIKey, string, error) {
+	args := m.Called(name, scopes, expiresAt)
+	if k := args.Get(0); k != nil {
+		return k.(*entity.APIKey), args.String(1), args.Error(2)
+	}
+	return nil, args.String(1), args.Error(2)
+}
+
+func (m *MockAPIKeyService) ListAPIKeys(ctx context.Context) ([]entity.APIKey, error) {
+	args := m.Called()
+	if k := args.Get(0); k != nil {
+		return k.([]entity.APIKey), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockAPIKeyService) RevokeAPIKey(ctx context.Context, id string) (*entity.APIKey, error) {
+	args := m.Called(id)
+	if k := args.Get(0); k != nil {
+		return k.(*entity.APIKey), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockAPIKeyService) Authenticate(ctx context.Context, secret string) (*entity.APIKey, error) {
+	args := m.Called(secret)
+	if k := args.Get(0); k != nil {
+		return k.(*entity.APIKey), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func TestAPIKeyHandler_CreateAPIKey(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	mockService := new(MockAPIKeyService)
+	handler := NewAPIKeyHandler(mockService)
+
+	scopes := []entity.APIKeyScope{entity.ScopeWeatherRead}
+	key := &entity.APIKey{ID: "abc123", Name: "nightly", Prefix: "wapi_Xk3q9Z", Hash: "secret-hash", Scopes: scopes, CreatedAt: time.Now()}
+	mockService.On("CreateAPIKey", "nightly", scopes, (*time.Time)(nil)).Return(key, "wapi_Xk3q9Zrest", nil)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodPost, "/admin/keys", strings.NewReader(`{"name": "nightly", "scopes": ["weather:read"]}`))
+	c.Request.Header.Set("Content-Type", "application/json")
+
+	// Act
+	handler.CreateAPIKey(c)
+
+	// Assert
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.NotContains(t, w.Body.String(), "secret-hash")
+
+	var response dto.CreatedAPIKeyResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.True(t, response.Success)
+	assert.Equal(t, "wapi_Xk3q9Zrest", response.Data.Key)
+	assert.Equal(t, "abc123", response.Data.ID)
+	assert.Equal(t, []string{"weather:read"}, response.Data.Scopes)
+	assert.Equal(t, "active", response.Data.Status)
+	mockService.AssertExpectations(t)
+}
+
+func TestAPIKeyHandler_CreateAPIKey_BadRequest(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		serviceErr error
+	}{
+		{"missing name", `{"scopes": ["weather:read"]}`, nil},
+		{"missing scopes", `{"name": "nightly"}`, nil},
+		{"unknown scope", `{"name": "nightly", "scopes": ["weather:write"]}`, service.ErrInvalidAPIKeyScope},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			gin.SetMode(gin.TestMode)
+			mockService := new(MockAPIKeyService)
+			handler := NewAPIKeyHandler(mockService)
+			if tt.serviceErr != nil {
+				mockService.On("CreateAPIKey", mock.Anything, mock.Anything, mock.Anything).Return(nil, "", tt.serviceErr)
+			}
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodPost, "/admin/keys", strings.NewReader(tt.body))
+			c.Request.Header.Set("Content-Type", "application/json")
+
+			// Act
+			handler.CreateAPIKey(c)
+
+			// Assert
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAPIKeyHandler_ListAPIKeys(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	mockService := new(MockAPIKeyService)
+	handler := NewAPIKeyHandler(mockService)
+
+	past := time.Now().Add(-time.Hour)
+	mockService.On("ListAPIKeys").Return([]entity.APIKey{
+		{ID: "a", Hash: "hash-a", Scopes: []entity.APIKeyScope{entity.ScopeAdmin}},
+		{ID: "b", Hash: "hash-b", ExpiresAt: &past},
+		{ID: "c", Hash: "hash-c", RevokedAt: &past},
+	}, nil)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/admin/keys", nil)
+
+	// Act
+	handler.ListAPIKeys(c)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.NotContains(t, w.Body.String(), "hash-")
+
+	var response dto.APIKeyListResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Len(t, response.Data, 3)
+	assert.Equal(t, "active", response.Data[0].Status)
+	assert.Equal(t, "expired", response.Data[1].Status)
+	assert.Equal(t, "revoked", response.Data[2].Status)
+}
+
+func TestAPIKeyHandler_RevokeAPIKey(t *testing.T) {
+	tests := []struct {
+		name       string
+		key        *entity.APIKey
+		err        error
+		wantStatus int
+	}{
+		{"revoked", &entity.APIKey{ID: "abc123", RevokedAt: func() *time.Time { now := time.Now(); return &now }()}, nil, http.StatusOK},
+		{"not found", nil, repository.ErrAPIKeyNotFound, http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			gin.SetMode(gin.TestMode)
+			mockService := new(MockAPIKeyService)
+			handler := NewAPIKeyHandler(mockService)
+			mockService.On("RevokeAPIKey", "abc123").Return(tt.key, tt.err)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodDelete, "/admin/keys/abc123", nil)
+			c.Params = gin.Params{{Key: "id", Value: "abc123"}}
+
+			// Act
+			handler.RevokeAPIKey(c)
+
+			// Assert
+			assert.Equal(t, tt.wantStatus, w.Code)
+			mockService.AssertExpectations(t)
+		})
+	}
+}
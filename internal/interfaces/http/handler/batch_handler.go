@@ -0,0 +1,222 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"weather-api/internal/core/domain/entity"
+	"weather-api/internal/core/service"
+	"weather-api/internal/dto"
+	"weather-api/internal/infrastructure/support"
+	"weather-api/pkg/language"
+	"weather-api/pkg/units"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+)
+
+// ndjsonContentType selects, and labels, the streamed form of the batch response.
+const ndjsonContentType = "application/x-ndjson"
+
+// BatchHandler handles HTTP requests for batch weather lookups.
+type BatchHandler struct {
+	batchService       service.BatchServiceInterface
+	streamWriteTimeout time.Duration
+}
+
+// NewBatchHandler creates a new batch handler. streamWriteTimeout bounds the write of each streamed
+// line in place of the server-wide write timeout, which a long stream would otherwise outlive.
+func NewBatchHandler(batchService service.BatchServiceInterface, streamWriteTimeout time.Duration) *BatchHandler {
+	return &BatchHandler{
+		batchService:       batchService,
+		streamWriteTimeout: streamWriteTimeout,
+	}
+}
+
+// GetWeatherBatch godoc
+// @Summary      Get weather for many locations
+// @Description  Retrieves the current weather for a list of cities and/or coordinates in one request. Each item names either a city or a lat/lon pair. Items are fetched concurrently and reported with their own success flag, HTTP status and error, so one failing location does not fail the batch.
+// @Description  By default the response is a single JSON document in request order. With `Accept: application/x-ndjson` it is streamed instead: one dto.BatchWeatherItemData object per line, written as each location completes (in completion order, tagged with its input index). Streamed batches accept more items, and disconnecting stops the remaining lookups.
+// @Tags         Weather
+// @Accept       json
+// @Produce      json
+// @Produce      application/x-ndjson
+// @Param        request  body      dto.BatchWeatherRequest  true   "Locations to look up"
+// @Param        units    query     string                   false  "Unit system: metric (default), imperial or standard"
+// @Param        lang     query     string                   false  "Description language, e.g. de or pt_br (default: best match from Accept-Language, then en)"
+// @Success      200  {object}  dto.BatchWeatherResponse  "Per-item results"
+// @Failure      400  {object}  dto.BatchWeatherResponse  "Malformed body, or the batch is empty or larger than the configured maximum"
+// @Failure      500  {object}  dto.BatchWeatherResponse  "Internal server error"
+// @Security     ApiKeyAuth
+// @Router       /weather/batch [post]
+func (h *BatchHandler) GetWeatherBatch(c *gin.Context) {
+	stream := c.NegotiateFormat(binding.MIMEJSON, ndjsonContentType) == ndjsonContentType
+	var maxItems int
+	if stream {
+		maxItems = h.batchService.MaxStreamItems()
+	} else {
+		maxItems = h.batchService.MaxItems()
+	}
+
+	var request dto.BatchWeatherRequest
+	// Items are validated one by one below so that a bad item fails alone instead of the whole batch.
+	if err := c.ShouldBindWith(&request, binding.JSON); err != nil {
+		writeError(c, support.NewErrBadRequest(err.Error()))
+		return
+	}
+	if len(request.Items) == 0 || len(request.Items) > maxItems {
+		writeError(c, support.NewErrBadRequest(fmt.Sprintf("batch must contain between 1 and %d items", maxItems)))
+		return
+	}
+
+	system, err := parseUnits(c.Query("units"))
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+	lang, err := resolveLanguage(c)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	var invalid []dto.BatchWeatherItemData
+	var queries []entity.BatchQuery
+	var positions []int
+	for index, item := range request.Items {
+		query, err := toBatchQuery(item)
+		if err != nil {
+			invalid = append(invalid, toBatchWeatherItemData(index, item, nil, err))
+			continue
+		}
+		queries = append(queries, query)
+		positions = append(positions, index)
+	}
+
+	if stream {
+		h.streamWeatherBatch(c, request.Items, invalid, queries, positions, system, lang)
+		return
+	}
+
+	items := make([]dto.BatchWeatherItemData, len(request.Items))
+	for _, item := range invalid {
+		items[item.Index] = item
+	}
+	if len(queries) > 0 {
+		results, err := h.batchService.GetWeatherBatch(c.Request.Context(), queries, system, lang)
+		if err != nil {
+			writeError(c, err)
+			return
+		}
+		for i, result := range results {
+			index := positions[i]
+			items[index] = toBatchWeatherItemData(index, request.Items[index], result.Weather, result.Err)
+		}
+	}
+
+	data := &dto.BatchWeatherData{Items: items}
+	for _, item := range items {
+		if item.Success {
+			data.Succeeded++
+		} else {
+			data.Failed++
+		}
+	}
+
+	c.JSON(http.StatusOK, dto.BatchWeatherResponse{Success: true, Data: data})
+}
+
+// streamWeatherBatch writes the invalid items and then every lookup as one NDJSON line the moment it
+// completes. Once the client is gone or a write fails, the remaining results are drained unwritten;
+// the canceled batch context makes the service skip their upstream calls.
+func (h *BatchHandler) streamWeatherBatch(c *gin.Context, items []dto.BatchWeatherItemRequest, invalid []dto.BatchWeatherItemData, queries []entity.BatchQuery, positions []int, system units.System, lang language.Code) {
+	// A failed write does not cancel the request context, so the batch gets its own.
+	ctx, cancel := context.WithCancel(c.Request.Context())
+	defer cancel()
+
+	var results <-chan entity.BatchResult
+	if len(queries) > 0 {
+		var err error
+		results, err = h.batchService.StreamWeatherBatch(ctx, queries, system, lang)
+		if err != nil {
+			writeError(c, err)
+			return
+		}
+	}
+
+	c.Header("Content-Type", ndjsonContentType)
+	c.Header("X-Content-Type-Options", "nosniff")
+	c.Status(http.StatusOK)
+
+	controller := http.NewResponseController(c.Writer)
+	encoder := json.NewEncoder(c.Writer)
+	failed := false
+	write := func(item dto.BatchWeatherItemData) {
+		if failed || ctx.Err() != nil {
+			return
+		}
+		// Not every writer supports deadlines (e.g. test recorders); the line is written regardless.
+		_ = controller.SetWriteDeadline(time.Now().Add(h.streamWriteTimeout))
+		if err := encoder.Encode(item); err != nil {
+			failed = true
+			cancel()
+			_ = c.Error(err)
+			return
+		}
+		c.Writer.Flush()
+	}
+
+	for _, item := range invalid {
+		write(item)
+	}
+	for result := range results {
+		index := positions[result.Index]
+		write(toBatchWeatherItemData(index, items[index], result.Weather, result.Err))
+	}
+}
+
+// toBatchQuery validates one batch item; it must name either a city or both coordinates.
+func toBatchQuery(item dto.BatchWeatherItemRequest) (entity.BatchQuery, error) {
+	if err := binding.Validator.ValidateStruct(item); err != nil {
+		return entity.BatchQuery{}, support.NewErrBadRequest(err.Error())
+	}
+
+	hasCoordinates := item.Lat != nil || item.Lon != nil
+	switch {
+	case item.City != "" && !hasCoordinates:
+		return entity.BatchQuery{City: item.City}, nil
+	case item.City == "" && item.Lat != nil && item.Lon != nil:
+		return entity.BatchQuery{Lat: *item.Lat, Lon: *item.Lon}, nil
+	}
+	return entity.BatchQuery{}, support.NewErrBadRequest("item must contain either city or both lat and lon")
+}
+
+// toBatchWeatherItemData maps the outcome of one batch item, using the same status codes the
+// single-location endpoints would return for err.
+func toBatchWeatherItemData(index int, query dto.BatchWeatherItemRequest, weather *entity.Weather, err error) dto.BatchWeatherItemData {
+	item := dto.BatchWeatherItemData{Index: index, Query: query}
+	if err != nil {
+		item.Status = errorStatus(err)
+		item.Error = err.Error()
+		return item
+	}
+
+	item.Success = true
+	item.Status = http.StatusOK
+	item.Data = &dto.WeatherData{
+		City:        weather.City,
+		Temperature: weather.Temperature,
+		Description: weather.Description,
+		Humidity:    weather.Humidity,
+		WindSpeed:   weather.WindSpeed,
+		Timestamp:   weather.Timestamp,
+		Units:       string(weather.Units),
+		Lang:        string(weather.Language),
+		Provider:    weather.Provider,
+		Consensus:   toWeatherConsensusData(weather.Consensus),
+	}
+	return item
+}
@@ -0,0 +1,319 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"weather-api/internal/core/domain/entity"
+	"weather-api/internal/core/service"
+	"weather-api/internal/dto"
+	"weather-api/internal/infrastructure/support"
+	"weather-api/pkg/language"
+	"weather-api/pkg/units"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockBatchService is a mock implementation for testing
+type MockBatchService struct {
+	mock.Mock
+}
+
+func (m *MockBatchService) GetWeatherBatch(ctx context.Context, queries []entity.BatchQuery, system units.System, lang language.Code) ([]entity.BatchResult, error) {
+	args := m.Called(queries, system, lang)
+	if r := args.Get(0); r != nil {
+		return r.([]entity.BatchResult), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockBatchService) StreamWeatherBatch(ctx context.Context, queries []entity.BatchQuery, system units.System, lang language.Code) (<-chan entity.BatchResult, error) {
+	args := m.Called(queries, system, lang)
+	if r := args.Get(0); r != nil {
+		return r.(<-chan entity.BatchResult), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockBatchService) MaxItems() int {
+	return m.Called().Int(0)
+}
+
+func (m *MockBatchService) MaxStreamItems() int {
+	return m.Called().Int(0)
+}
+
+// cancelAwareWeatherService records every city it is asked for and holds each lookup until ctx ends,
+// answering anyway after a second so a batch that is never canceled still finishes.
+type cancelAwareWeatherService struct {
+	*MockWeatherService
+	mu      sync.Mutex
+	fetched []string
+}
+
+func (s *cancelAwareWeatherService) GetWeatherByCity(ctx context.Context, city string, system units.System, lang language.Code) (*entity.Weather, error) {
+	s.mu.Lock()
+	s.fetched = append(s.fetched, city)
+	s.mu.Unlock()
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-time.After(time.Second):
+		return &entity.Weather{City: city, Units: system, Language: lang}, nil
+	}
+}
+
+// failingResponseWriter fails every body write, like a connection the client has dropped.
+type failingResponseWriter struct {
+	*httptest.ResponseRecorder
+}
+
+func (w failingResponseWriter) Write(b []byte) (int, error) {
+	return 0, errors.New("connection reset by peer")
+}
+
+func newBatchContext(body string) (*gin.Context, *httptest.ResponseRecorder) {
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodPost, "/weather/batch", strings.NewReader(body))
+	c.Request.Header.Set("Content-Type", "application/json")
+	return c, w
+}
+
+func TestBatchHandler_GetWeatherBatch_MixedResults(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	mockService := new(MockBatchService)
+	handler := NewBatchHandler(mockService, time.Second)
+
+	queries := []entity.BatchQuery{{City: "London"}, {City: "Atlantis"}, {Lat: 38.5, Lon: 27.1}}
+	mockService.On("MaxItems").Return(10)
+	mockService.On("GetWeatherBatch", queries, units.Metric, language.English).Return([]entity.BatchResult{
+		{Query: queries[0], Weather: &entity.Weather{City: "London", Temperature: 15.5, Units: units.Metric, Language: language.English}},
+		{Query: queries[1], Err: support.NewErrNotFound("city not found")},
+		{Query: queries[2], Weather: &entity.Weather{Temperature: 21, Units: units.Metric, Language: language.English}},
+	}, nil)
+
+	c, w := newBatchContext(`{"items": [{"city": "London"}, {"city": "Atlantis"}, {"lat": 38.5, "lon": 27.1}]}`)
+
+	// Act
+	handler.GetWeatherBatch(c)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response dto.BatchWeatherResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.True(t, response.Success)
+	assert.Equal(t, 2, response.Data.Succeeded)
+	assert.Equal(t, 1, response.Data.Failed)
+	assert.Len(t, response.Data.Items, 3)
+
+	assert.True(t, response.Data.Items[0].Success)
+	assert.Equal(t, "London", response.Data.Items[0].Data.City)
+	assert.Equal(t, "metric", response.Data.Items[0].Data.Units)
+
+	assert.False(t, response.Data.Items[1].Success)
+	assert.Equal(t, http.StatusNotFound, response.Data.Items[1].Status)
+	assert.Equal(t, "city not found", response.Data.Items[1].Error)
+	assert.Nil(t, response.Data.Items[1].Data)
+
+	assert.Equal(t, 2, response.Data.Items[2].Index)
+	assert.Equal(t, 21.0, response.Data.Items[2].Data.Temperature)
+	mockService.AssertExpectations(t)
+}
+
+func TestBatchHandler_GetWeatherBatch_InvalidItem(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	mockService := new(MockBatchService)
+	handler := NewBatchHandler(mockService, time.Second)
+
+	mockService.On("MaxItems").Return(10)
+	mockService.On("GetWeatherBatch", []entity.BatchQuery{{City: "Paris"}}, units.Metric, language.English).Return([]entity.BatchResult{
+		{Query: entity.BatchQuery{City: "Paris"}, Weather: &entity.Weather{City: "Paris"}},
+	}, nil)
+
+	c, w := newBatchContext(`{"items": [{"city": "London", "lat": 51.5, "lon": 0}, {"lat": 95, "lon": 0}, {"lat": 10}, {"city": "Paris"}]}`)
+
+	// Act
+	handler.GetWeatherBatch(c)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response dto.BatchWeatherResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, response.Data.Succeeded)
+	assert.Equal(t, 3, response.Data.Failed)
+	for _, item := range response.Data.Items[:3] {
+		assert.Equal(t, http.StatusBadRequest, item.Status)
+		assert.NotEmpty(t, item.Error)
+	}
+	assert.Equal(t, 3, response.Data.Items[3].Index)
+	assert.Equal(t, "Paris", response.Data.Items[3].Data.City)
+	mockService.AssertExpectations(t)
+}
+
+func TestBatchHandler_GetWeatherBatch_BadRequest(t *testing.T) {
+	tests := []struct {
+		name  string
+		body  string
+		query string
+	}{
+		{"malformed body", `{"items": [`, ""},
+		{"empty batch", `{"items": []}`, ""},
+		{"too many items", `{"items": [{"city": "London"}, {"city": "Paris"}, {"city": "Rome"}]}`, ""},
+		{"invalid units", `{"items": [{"city": "London"}]}`, "units=kelvin"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			gin.SetMode(gin.TestMode)
+			mockService := new(MockBatchService)
+			handler := NewBatchHandler(mockService, time.Second)
+			mockService.On("MaxItems").Return(2)
+
+			c, w := newBatchContext(tt.body)
+			c.Request.URL.RawQuery = tt.query
+
+			// Act
+			handler.GetWeatherBatch(c)
+
+			// Assert
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+
+			var response dto.BatchWeatherResponse
+			err := json.Unmarshal(w.Body.Bytes(), &response)
+			assert.NoError(t, err)
+			assert.False(t, response.Success)
+			mockService.AssertNotCalled(t, "GetWeatherBatch", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestBatchHandler_GetWeatherBatch_Stream(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	mockService := new(MockBatchService)
+	handler := NewBatchHandler(mockService, time.Second)
+
+	queries := []entity.BatchQuery{{City: "London"}, {City: "Atlantis"}}
+	results := make(chan entity.BatchResult, 2)
+	results <- entity.BatchResult{Index: 1, Query: queries[1], Err: support.NewErrNotFound("city not found")}
+	results <- entity.BatchResult{Index: 0, Query: queries[0], Weather: &entity.Weather{City: "London", Units: units.Metric}}
+	close(results)
+
+	mockService.On("MaxStreamItems").Return(100)
+	mockService.On("StreamWeatherBatch", queries, units.Metric, language.English).Return((<-chan entity.BatchResult)(results), nil)
+
+	c, w := newBatchContext(`{"items": [{"lat": 10}, {"city": "London"}, {"city": "Atlantis"}]}`)
+	c.Request.Header.Set("Accept", "application/x-ndjson")
+
+	// Act
+	handler.GetWeatherBatch(c)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "application/x-ndjson", w.Header().Get("Content-Type"))
+	assert.True(t, w.Flushed)
+
+	lines := strings.Split(strings.TrimSpace(w.Body.String()), "\n")
+	assert.Len(t, lines, 3)
+	items := make([]dto.BatchWeatherItemData, len(lines))
+	for i, line := range lines {
+		assert.NoError(t, json.Unmarshal([]byte(line), &items[i]))
+	}
+
+	// The invalid item is written first, then lookups in completion order with their input index.
+	assert.Equal(t, 0, items[0].Index)
+	assert.Equal(t, http.StatusBadRequest, items[0].Status)
+	assert.Equal(t, 2, items[1].Index)
+	assert.Equal(t, http.StatusNotFound, items[1].Status)
+	assert.Equal(t, 1, items[2].Index)
+	assert.Equal(t, "London", items[2].Data.City)
+	mockService.AssertExpectations(t)
+}
+
+func TestBatchHandler_GetWeatherBatch_StreamClientGone(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	mockService := new(MockBatchService)
+	handler := NewBatchHandler(mockService, time.Second)
+
+	results := make(chan entity.BatchResult, 1)
+	results <- entity.BatchResult{Index: 0, Query: entity.BatchQuery{City: "London"}, Err: context.Canceled}
+	close(results)
+
+	mockService.On("MaxStreamItems").Return(100)
+	mockService.On("StreamWeatherBatch", mock.Anything, units.Metric, language.English).Return((<-chan entity.BatchResult)(results), nil)
+
+	c, w := newBatchContext(`{"items": [{"city": "London"}]}`)
+	c.Request.Header.Set("Accept", "application/x-ndjson")
+	ctx, cancel := context.WithCancel(c.Request.Context())
+	cancel()
+	c.Request = c.Request.WithContext(ctx)
+
+	// Act
+	handler.GetWeatherBatch(c)
+
+	// Assert
+	assert.Empty(t, w.Body.String())
+	mockService.AssertExpectations(t)
+}
+
+func TestBatchHandler_GetWeatherBatch_StreamWriteError(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	weatherService := &cancelAwareWeatherService{MockWeatherService: new(MockWeatherService)}
+	handler := NewBatchHandler(service.NewBatchService(weatherService, 10, 10, 1), time.Second)
+
+	c, _ := gin.CreateTestContext(failingResponseWriter{httptest.NewRecorder()})
+	c.Request = httptest.NewRequest(http.MethodPost, "/weather/batch",
+		strings.NewReader(`{"items": [{"lat": 10}, {"city": "London"}, {"city": "Paris"}, {"city": "Rome"}]}`))
+	c.Request.Header.Set("Content-Type", "application/json")
+	c.Request.Header.Set("Accept", "application/x-ndjson")
+
+	// Act
+	start := time.Now()
+	handler.GetWeatherBatch(c)
+
+	// Assert
+	// Writing the invalid item fails first; a lookup already running is canceled and the rest never start.
+	assert.Less(t, time.Since(start), time.Second)
+	assert.NotContains(t, weatherService.fetched, "Paris")
+	assert.NotContains(t, weatherService.fetched, "Rome")
+	assert.Len(t, c.Errors, 1)
+}
+
+func TestBatchHandler_GetWeatherBatch_StreamLimit(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	mockService := new(MockBatchService)
+	handler := NewBatchHandler(mockService, time.Second)
+	mockService.On("MaxStreamItems").Return(1)
+
+	c, w := newBatchContext(`{"items": [{"city": "London"}, {"city": "Paris"}]}`)
+	c.Request.Header.Set("Accept", "application/x-ndjson")
+
+	// Act
+	handler.GetWeatherBatch(c)
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockService.AssertNotCalled(t, "MaxItems")
+	mockService.AssertNotCalled(t, "StreamWeatherBatch", mock.Anything, mock.Anything, mock.Anything)
+}
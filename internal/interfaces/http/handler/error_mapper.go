@@ -1,45 +1,85 @@
 package handler
 
 import (
+	"context"
+	"errors"
+	"math"
 	"net/http"
+	"strconv"
 
 	"weather-api/internal/dto"
 	"weather-api/internal/infrastructure/support"
+	"weather-api/pkg/circuitbreaker"
 
 	"github.com/gin-gonic/gin"
 )
 
+// statusClientClosedRequest is the non-standard status, borrowed from nginx, recorded for requests
+// whose client went away before the answer was ready.
+const statusClientClosedRequest = 499
+
 // writeError maps known error types to HTTP status codes and writes a consistent response envelope.
+// An exhausted upstream quota also sets Retry-After to the time until the budget resets, and a throttled
+// upstream to the wait it asked for. A request the client canceled only gets its status recorded, since
+// nobody is left to read a body.
 func writeError(c *gin.Context, err error) {
 	// Attach error to context so logging middleware can record it for non-4xx as well
 	_ = c.Error(err)
 
+	if errors.Is(err, context.Canceled) {
+		c.Status(statusClientClosedRequest)
+		return
+	}
+
+	var quotaErr *support.ErrQuotaExceeded
+	var rateLimitedErr *support.ErrUpstreamRateLimited
+	switch {
+	case errors.As(err, &quotaErr) && quotaErr.RetryAfter > 0:
+		c.Header("Retry-After", strconv.Itoa(int(math.Ceil(quotaErr.RetryAfter.Seconds()))))
+	case errors.As(err, &rateLimitedErr) && rateLimitedErr.RetryAfter > 0:
+		c.Header("Retry-After", strconv.Itoa(int(math.Ceil(rateLimitedErr.RetryAfter.Seconds()))))
+	}
+
+	c.JSON(errorStatus(err), dto.WeatherResponse{Success: false, Error: err.Error()})
+}
+
+// errorStatus returns the HTTP status code for err; unknown errors are internal errors. A call refused
+// by an open or saturated circuit breaker is 503, and a canceled request 499.
+func errorStatus(err error) int {
+	switch {
+	case circuitbreaker.IsRejected(err):
+		return http.StatusServiceUnavailable
+	case errors.Is(err, context.Canceled):
+		return statusClientClosedRequest
+	}
+
 	switch e := err.(type) {
 	case *support.ErrBadRequest:
-		c.JSON(http.StatusBadRequest, dto.WeatherResponse{Success: false, Error: e.Error()})
-		return
+		return http.StatusBadRequest
 	case *support.ErrUnauthorized:
-		c.JSON(http.StatusUnauthorized, dto.WeatherResponse{Success: false, Error: e.Error()})
-		return
+		return http.StatusUnauthorized
 	case *support.ErrForbidden:
-		c.JSON(http.StatusForbidden, dto.WeatherResponse{Success: false, Error: e.Error()})
-		return
+		return http.StatusForbidden
 	case *support.ErrNotFound:
-		c.JSON(http.StatusNotFound, dto.WeatherResponse{Success: false, Error: e.Error()})
-		return
+		return http.StatusNotFound
+	case *support.ErrTooManyRequests:
+		return http.StatusTooManyRequests
 	case *support.ErrTimeout:
-		c.JSON(http.StatusGatewayTimeout, dto.WeatherResponse{Success: false, Error: e.Error()})
-		return
+		return http.StatusGatewayTimeout
+	case *support.ErrNotImplemented:
+		return http.StatusNotImplemented
+	case *support.ErrQuotaExceeded, *support.ErrUpstreamRateLimited:
+		return http.StatusServiceUnavailable
+	case *support.ErrUpstreamUnauthorized:
+		return http.StatusBadGateway
 	case *support.ErrUpstream:
 		// Map 502/503 if provided, fallback to 502
-		status := e.StatusCode
-		if status != http.StatusBadGateway && status != http.StatusServiceUnavailable {
-			status = http.StatusBadGateway
+		if e.StatusCode == http.StatusServiceUnavailable {
+			return http.StatusServiceUnavailable
 		}
-		c.JSON(status, dto.WeatherResponse{Success: false, Error: e.Error()})
-		return
+		return http.StatusBadGateway
 	}
 
 	// Default: internal error
-	c.JSON(http.StatusInternalServerError, dto.WeatherResponse{Success: false, Error: err.Error()})
+	return http.StatusInternalServerError
 }
@@ -0,0 +1,109 @@
+package handler
+
+import (
+	"net/http"
+
+	"weather-api/internal/core/domain/entity"
+	"weather-api/internal/core/service"
+	"weather-api/internal/dto"
+	"weather-api/internal/infrastructure/support"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GeoHandler handles HTTP requests for geocoding endpoints.
+type GeoHandler struct {
+	geocodingService service.GeocodingServiceInterface
+}
+
+// NewGeoHandler creates a new geocoding handler.
+func NewGeoHandler(geocodingService service.GeocodingServiceInterface) *GeoHandler {
+	return &GeoHandler{
+		geocodingService: geocodingService,
+	}
+}
+
+// SearchLocations godoc
+// @Summary      Search locations by name
+// @Description  Resolves a place name (optionally with state and country code, e.g. "Paris, FR") to matching locations.
+// @Tags         Geocoding
+// @Accept       json
+// @Produce      json
+// @Param        q      query     string   true   "Place name, e.g. Paris,FR or Paris,TX,US"
+// @Param        limit  query     integer  false  "Maximum number of matches (1-5, default 5)"
+// @Success      200  {object}  dto.LocationsResponse  "Matching locations (possibly empty)"
+// @Failure      400  {object}  dto.LocationsResponse  "Invalid request (e.g., q is missing)"
+// @Failure      500  {object}  dto.LocationsResponse  "Internal server error"
+// @Security     ApiKeyAuth
+// @Router       /geo/search [get]
+func (h *GeoHandler) SearchLocations(c *gin.Context) {
+	var input struct {
+		Query string `form:"q" binding:"required,min=2,max=100"`
+		Limit int    `form:"limit" binding:"omitempty,gte=1,lte=5"`
+	}
+
+	if err := c.ShouldBindQuery(&input); err != nil {
+		writeError(c, support.NewErrBadRequest(err.Error()))
+		return
+	}
+
+	locations, err := h.geocodingService.SearchLocations(c.Request.Context(), input.Query, input.Limit)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.LocationsResponse{Success: true, Data: toLocationData(locations)})
+}
+
+// ReverseGeocode godoc
+// @Summary      Reverse geocode a coordinate
+// @Description  Returns the named locations closest to a coordinate.
+// @Tags         Geocoding
+// @Accept       json
+// @Produce      json
+// @Param        lat    query     number   true   "Lat"
+// @Param        lon    query     number   true   "Lon"
+// @Param        limit  query     integer  false  "Maximum number of matches (1-5, default 5)"
+// @Success      200  {object}  dto.LocationsResponse  "Matching locations (possibly empty)"
+// @Failure      400  {object}  dto.LocationsResponse  "Invalid request (e.g., lat out of range)"
+// @Failure      500  {object}  dto.LocationsResponse  "Internal server error"
+// @Failure      501  {object}  dto.LocationsResponse  "Not supported by the configured weather provider"
+// @Security     ApiKeyAuth
+// @Router       /geo/reverse [get]
+func (h *GeoHandler) ReverseGeocode(c *gin.Context) {
+	var input struct {
+		Lat   *float32 `form:"lat" binding:"required,gte=-90,lte=90"`
+		Lon   *float32 `form:"lon" binding:"required,gte=-180,lte=180"`
+		Limit int      `form:"limit" binding:"omitempty,gte=1,lte=5"`
+	}
+
+	if err := c.ShouldBindQuery(&input); err != nil {
+		writeError(c, support.NewErrBadRequest(err.Error()))
+		return
+	}
+
+	locations, err := h.geocodingService.ReverseGeocode(c.Request.Context(), *input.Lat, *input.Lon, input.Limit)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.LocationsResponse{Success: true, Data: toLocationData(locations)})
+}
+
+// toLocationData maps geocoding results to response DTOs.
+func toLocationData(locations []entity.Location) []dto.LocationData {
+	data := make([]dto.LocationData, 0, len(locations))
+	for _, loc := range locations {
+		data = append(data, dto.LocationData{
+			Name:       loc.Name,
+			LocalNames: loc.LocalNames,
+			Country:    loc.Country,
+			State:      loc.State,
+			Lat:        loc.Lat,
+			Lon:        loc.Lon,
+		})
+	}
+	return data
+}
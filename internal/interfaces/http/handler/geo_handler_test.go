@@ -0,0 +1,154 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"weather-api/internal/core/domain/entity"
+	"weather-api/internal/dto"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockGeocodingService is a mock implementation for testing
+type MockGeocodingService struct {
+	mock.Mock
+}
+
+func (m *MockGeocodingService) SearchLocations(ctx context.Context, query string, limit int) ([]entity.Location, error) {
+	args := m.Called(query, limit)
+	if l := args.Get(0); l != nil {
+		return l.([]entity.Location), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockGeocodingService) ReverseGeocode(ctx context.Context, lat float32, lon float32, limit int) ([]entity.Location, error) {
+	args := m.Called(lat, lon, limit)
+	if l := args.Get(0); l != nil {
+		return l.([]entity.Location), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func TestGeoHandler_SearchLocations_Success(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	mockService := new(MockGeocodingService)
+	handler := NewGeoHandler(mockService)
+
+	mockService.On("SearchLocations", "Paris, TX", 0).Return([]entity.Location{
+		{Name: "Paris", Country: "US", State: "Texas", Lat: 33.66, Lon: -95.55, LocalNames: map[string]string{"en": "Paris"}},
+	}, nil)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/geo/search?q=Paris,%20TX", nil)
+
+	// Act
+	handler.SearchLocations(c)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response dto.LocationsResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.True(t, response.Success)
+	assert.Len(t, response.Data, 1)
+	assert.Equal(t, "Texas", response.Data[0].State)
+	assert.Equal(t, "Paris", response.Data[0].LocalNames["en"])
+
+	mockService.AssertExpectations(t)
+}
+
+func TestGeoHandler_SearchLocations_MissingQuery(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	mockService := new(MockGeocodingService)
+	handler := NewGeoHandler(mockService)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/geo/search", nil)
+
+	// Act
+	handler.SearchLocations(c)
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockService.AssertNotCalled(t, "SearchLocations", mock.Anything, mock.Anything)
+}
+
+func TestGeoHandler_ReverseGeocode_Success(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	mockService := new(MockGeocodingService)
+	handler := NewGeoHandler(mockService)
+
+	mockService.On("ReverseGeocode", float32(48.85), float32(2.35), 1).Return([]entity.Location{
+		{Name: "Paris", Country: "FR"},
+	}, nil)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/geo/reverse?lat=48.85&lon=2.35&limit=1", nil)
+
+	// Act
+	handler.ReverseGeocode(c)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response dto.LocationsResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, "FR", response.Data[0].Country)
+
+	mockService.AssertExpectations(t)
+}
+
+func TestGeoHandler_ReverseGeocode_ZeroCoordinates(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	mockService := new(MockGeocodingService)
+	handler := NewGeoHandler(mockService)
+
+	mockService.On("ReverseGeocode", float32(0), float32(6.73), 1).Return([]entity.Location{
+		{Name: "São Tomé", Country: "ST"},
+	}, nil)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/geo/reverse?lat=0&lon=6.73&limit=1", nil)
+
+	// Act
+	handler.ReverseGeocode(c)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestGeoHandler_ReverseGeocode_InvalidLimit(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	mockService := new(MockGeocodingService)
+	handler := NewGeoHandler(mockService)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/geo/reverse?lat=48.85&lon=2.35&limit=10", nil)
+
+	// Act
+	handler.ReverseGeocode(c)
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockService.AssertNotCalled(t, "ReverseGeocode", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,51 @@
+package handler
+
+import (
+	"net/http"
+
+	"weather-api/internal/core/service"
+	"weather-api/internal/dto"
+
+	"github.com/gin-gonic/gin"
+)
+
+// HealthHandler handles HTTP requests for the liveness and readiness probes.
+type HealthHandler struct {
+	healthService service.HealthServiceInterface
+}
+
+// NewHealthHandler creates a new health handler.
+func NewHealthHandler(healthService service.HealthServiceInterface) *HealthHandler {
+	return &HealthHandler{
+		healthService: healthService,
+	}
+}
+
+// Liveness godoc
+// @Summary      Liveness probe
+// @Description  Reports that the process is up and serving requests. It checks no dependency, so a failing upstream never gets the process restarted.
+// @Tags         Health
+// @Produce      json
+// @Success      200  {object}  dto.LivenessResponse  "The process is alive"
+// @Router       /livez [get]
+func (h *HealthHandler) Liveness(c *gin.Context) {
+	c.JSON(http.StatusOK, dto.LivenessResponse{Success: true, Data: &dto.LivenessData{Status: "alive"}})
+}
+
+// Readiness godoc
+// @Summary      Readiness probe
+// @Description  Runs the readiness checks (upstream probe, circuit breakers, API key store) and reports each check's status and latency. A single open circuit breaker only degrades the service; it is unready once every breaker of the primary provider is open. Upstream probe results are reused for HEALTH_PROBE_TTL so that probing cannot spend the upstream quota.
+// @Tags         Health
+// @Produce      json
+// @Success      200  {object}  dto.ReadinessResponse  "No check is down; status is degraded when an optional check failed"
+// @Failure      503  {object}  dto.ReadinessResponse  "At least one check is down"
+// @Router       /readyz [get]
+func (h *HealthHandler) Readiness(c *gin.Context) {
+	report := h.healthService.Readiness(c.Request.Context())
+	if !report.Ready {
+		c.JSON(http.StatusServiceUnavailable, dto.ReadinessResponse{Success: false, Data: toReadinessData(report), Error: "service is not ready"})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ReadinessResponse{Success: true, Data: toReadinessData(report)})
+}
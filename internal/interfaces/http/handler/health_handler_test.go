@@ -0,0 +1,99 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"weather-api/internal/core/domain/entity"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+type stubHealthService struct {
+	report entity.HealthReport
+}
+
+func (s *stubHealthService) Readiness(ctx context.Context) entity.HealthReport {
+	return s.report
+}
+
+func TestHealthHandler_Liveness(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	handler := NewHealthHandler(&stubHealthService{})
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/livez", nil)
+
+	// Act
+	handler.Liveness(c)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"success": true, "data": {"status": "alive"}}`, w.Body.String())
+}
+
+func TestHealthHandler_Readiness(t *testing.T) {
+	checkedAt := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	upstream := entity.HealthCheckResult{Name: "upstream:openweather", Status: entity.HealthStatusUp, Latency: 84200 * time.Microsecond, CheckedAt: checkedAt, Cached: true}
+	breaker := entity.HealthCheckResult{Name: "circuit_breaker:openweather-onecall", Status: entity.HealthStatusDegraded, Error: "circuit breaker openweather-onecall is open", Latency: 2 * time.Microsecond, CheckedAt: checkedAt}
+	breakers := entity.HealthCheckResult{Name: "circuit_breakers", Status: entity.HealthStatusDown, Error: "every circuit breaker is open", Latency: 3 * time.Microsecond, CheckedAt: checkedAt}
+
+	tests := []struct {
+		name     string
+		report   entity.HealthReport
+		wantCode int
+		wantBody string
+	}{
+		{
+			name:     "ready",
+			report:   entity.HealthReport{Ready: true, Checks: []entity.HealthCheckResult{upstream}},
+			wantCode: http.StatusOK,
+			wantBody: `{"success": true, "data": {"status": "ready", "checks": [
+				{"name": "upstream:openweather", "status": "up", "latency_ms": 84.2, "checked_at": "2024-01-01T12:00:00Z", "cached": true}
+			]}}`,
+		},
+		{
+			name:     "degraded",
+			report:   entity.HealthReport{Ready: true, Degraded: true, Checks: []entity.HealthCheckResult{upstream, breaker}},
+			wantCode: http.StatusOK,
+			wantBody: `{"success": true, "data": {"status": "degraded", "checks": [
+				{"name": "upstream:openweather", "status": "up", "latency_ms": 84.2, "checked_at": "2024-01-01T12:00:00Z", "cached": true},
+				{"name": "circuit_breaker:openweather-onecall", "status": "degraded", "error": "circuit breaker openweather-onecall is open", "latency_ms": 0.002, "checked_at": "2024-01-01T12:00:00Z", "cached": false}
+			]}}`,
+		},
+		{
+			name:     "not ready",
+			report:   entity.HealthReport{Ready: false, Checks: []entity.HealthCheckResult{upstream, breakers}},
+			wantCode: http.StatusServiceUnavailable,
+			wantBody: `{"success": false, "error": "service is not ready", "data": {"status": "not_ready", "checks": [
+				{"name": "upstream:openweather", "status": "up", "latency_ms": 84.2, "checked_at": "2024-01-01T12:00:00Z", "cached": true},
+				{"name": "circuit_breakers", "status": "down", "error": "every circuit breaker is open", "latency_ms": 0.003, "checked_at": "2024-01-01T12:00:00Z", "cached": false}
+			]}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			gin.SetMode(gin.TestMode)
+			handler := NewHealthHandler(&stubHealthService{report: tt.report})
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodGet, "/readyz", nil)
+
+			// Act
+			handler.Readiness(c)
+
+			// Assert
+			assert.Equal(t, tt.wantCode, w.Code)
+			assert.JSONEq(t, tt.wantBody, w.Body.String())
+		})
+	}
+}
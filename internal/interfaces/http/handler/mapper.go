@@ -0,0 +1,334 @@
+package handler
+
+import (
+	"time"
+
+	"weather-api/internal/core/domain/entity"
+	"weather-api/internal/dto"
+)
+
+// toOneCallData maps a One Call domain entity to its response DTO.
+func toOneCallData(oneCall *entity.OneCall) *dto.OneCallData {
+	data := &dto.OneCallData{
+		Lat:            oneCall.Lat,
+		Lon:            oneCall.Lon,
+		Timezone:       oneCall.Timezone,
+		TimezoneOffset: oneCall.TimezoneOffset,
+		Alerts:         toWeatherAlertData(oneCall.Alerts),
+		Units:          string(oneCall.Units),
+		Lang:           string(oneCall.Language),
+		Provider:       oneCall.Provider,
+	}
+
+	if cur := oneCall.Current; cur != nil {
+		data.Current = &dto.CurrentConditionsData{
+			Time:        cur.Time,
+			Sunrise:     cur.Sunrise,
+			Sunset:      cur.Sunset,
+			Temperature: cur.Temperature,
+			FeelsLike:   cur.FeelsLike,
+			Pressure:    cur.Pressure,
+			Humidity:    cur.Humidity,
+			DewPoint:    cur.DewPoint,
+			UVIndex:     cur.UVIndex,
+			Clouds:      cur.Clouds,
+			Visibility:  cur.Visibility,
+			WindSpeed:   cur.WindSpeed,
+			WindDeg:     cur.WindDeg,
+			Description: cur.Description,
+		}
+	}
+
+	for _, m := range oneCall.Minutely {
+		data.Minutely = append(data.Minutely, dto.MinutelyPrecipitationData{
+			Time:          m.Time,
+			Precipitation: m.Precipitation,
+		})
+	}
+
+	for _, h := range oneCall.Hourly {
+		data.Hourly = append(data.Hourly, dto.HourlyConditionsData{
+			Time:                     h.Time,
+			Temperature:              h.Temperature,
+			FeelsLike:                h.FeelsLike,
+			Humidity:                 h.Humidity,
+			WindSpeed:                h.WindSpeed,
+			Description:              h.Description,
+			PrecipitationProbability: h.PrecipitationProbability,
+		})
+	}
+
+	for _, d := range oneCall.Daily {
+		data.Daily = append(data.Daily, dto.DailyConditionsData{
+			Date:                     d.Date,
+			Summary:                  d.Summary,
+			TempMin:                  d.TempMin,
+			TempMax:                  d.TempMax,
+			TempDay:                  d.TempDay,
+			TempNight:                d.TempNight,
+			Humidity:                 d.Humidity,
+			WindSpeed:                d.WindSpeed,
+			Description:              d.Description,
+			PrecipitationProbability: d.PrecipitationProbability,
+			Rain:                     d.Rain,
+			UVIndex:                  d.UVIndex,
+		})
+	}
+
+	return data
+}
+
+// toWeatherAlertData maps domain alerts to response DTOs.
+func toWeatherAlertData(alerts []entity.WeatherAlert) []dto.WeatherAlertData {
+	if alerts == nil {
+		return nil
+	}
+
+	data := make([]dto.WeatherAlertData, 0, len(alerts))
+	for _, alert := range alerts {
+		data = append(data, dto.WeatherAlertData{
+			SenderName:  alert.SenderName,
+			Event:       alert.Event,
+			Start:       alert.Start,
+			End:         alert.End,
+			Description: alert.Description,
+			Tags:        alert.Tags,
+			Severity:    alert.Severity.String(),
+		})
+	}
+	return data
+}
+
+// toAirQualityData maps an air quality entity to its response DTO.
+func toAirQualityData(airQuality *entity.AirQuality) *dto.AirQualityData {
+	readings := make([]dto.AirQualityReadingData, 0, len(airQuality.Readings))
+	for _, reading := range airQuality.Readings {
+		readings = append(readings, dto.AirQualityReadingData{
+			Time:     reading.Time,
+			AQI:      reading.AQI,
+			AQILabel: entity.AQILabel(reading.AQI),
+			Components: dto.AirComponentsData{
+				CO:    reading.Components.CO,
+				NO:    reading.Components.NO,
+				NO2:   reading.Components.NO2,
+				O3:    reading.Components.O3,
+				SO2:   reading.Components.SO2,
+				PM2_5: reading.Components.PM2_5,
+				PM10:  reading.Components.PM10,
+				NH3:   reading.Components.NH3,
+			},
+		})
+	}
+
+	return &dto.AirQualityData{
+		Lat:      airQuality.Lat,
+		Lon:      airQuality.Lon,
+		Readings: readings,
+		Provider: airQuality.Provider,
+	}
+}
+
+// historyDateLayout is the calendar date format used by the history range endpoint.
+const historyDateLayout = "2006-01-02"
+
+// toHistoricalWeatherData maps point-in-time historical observations to the response DTO.
+func toHistoricalWeatherData(historical *entity.HistoricalWeather) *dto.HistoricalWeatherData {
+	observations := make([]dto.HistoricalObservationData, 0, len(historical.Observations))
+	for _, o := range historical.Observations {
+		observations = append(observations, dto.HistoricalObservationData{
+			Time:        o.Time,
+			Temperature: o.Temperature,
+			FeelsLike:   o.FeelsLike,
+			Pressure:    o.Pressure,
+			Humidity:    o.Humidity,
+			DewPoint:    o.DewPoint,
+			Clouds:      o.Clouds,
+			Visibility:  o.Visibility,
+			WindSpeed:   o.WindSpeed,
+			WindDeg:     o.WindDeg,
+			Description: o.Description,
+		})
+	}
+
+	return &dto.HistoricalWeatherData{
+		Lat:          historical.Lat,
+		Lon:          historical.Lon,
+		Timezone:     historical.Timezone,
+		Observations: observations,
+		Units:        string(historical.Units),
+		Lang:         string(historical.Language),
+		Provider:     historical.Provider,
+	}
+}
+
+// toHistoricalRangeData maps an aggregated history range to the response DTO.
+func toHistoricalRangeData(historyRange *entity.HistoricalRange) *dto.HistoricalRangeData {
+	days := make([]dto.DaySummaryData, 0, len(historyRange.Days))
+	for _, d := range historyRange.Days {
+		days = append(days, dto.DaySummaryData{
+			Date:                d.Date.Format(historyDateLayout),
+			TempMin:             d.TempMin,
+			TempMax:             d.TempMax,
+			TempMorning:         d.TempMorning,
+			TempAfternoon:       d.TempAfternoon,
+			TempEvening:         d.TempEvening,
+			TempNight:           d.TempNight,
+			HumidityAfternoon:   d.HumidityAfternoon,
+			CloudCoverAfternoon: d.CloudCoverAfternoon,
+			PressureAfternoon:   d.PressureAfternoon,
+			Precipitation:       d.Precipitation,
+			WindMaxSpeed:        d.WindMaxSpeed,
+			WindMaxDirection:    d.WindMaxDirection,
+		})
+	}
+
+	return &dto.HistoricalRangeData{
+		Lat:                historyRange.Lat,
+		Lon:                historyRange.Lon,
+		From:               historyRange.From.Format(historyDateLayout),
+		To:                 historyRange.To.Format(historyDateLayout),
+		TempMin:            historyRange.TempMin,
+		TempMax:            historyRange.TempMax,
+		TempMean:           historyRange.TempMean,
+		TotalPrecipitation: historyRange.TotalPrecipitation,
+		MaxWindSpeed:       historyRange.MaxWindSpeed,
+		Days:               days,
+		Units:              string(historyRange.Units),
+	}
+}
+
+// toWeatherConsensusData maps blending details to the response DTO; nil when the reading was not blended.
+func toWeatherConsensusData(consensus *entity.WeatherConsensus) *dto.WeatherConsensusData {
+	if consensus == nil {
+		return nil
+	}
+	return &dto.WeatherConsensusData{
+		Strategy:          consensus.Strategy,
+		Providers:         consensus.Providers,
+		FailedProviders:   consensus.FailedProviders,
+		TemperatureSpread: consensus.TemperatureSpread,
+		HumiditySpread:    consensus.HumiditySpread,
+		WindSpeedSpread:   consensus.WindSpeedSpread,
+	}
+}
+
+// toShadowStatsData maps shadow divergence statistics to the response DTO.
+func toShadowStatsData(stats entity.ShadowStats) *dto.ShadowStatsData {
+	operations := make(map[string]dto.ShadowOperationStatsData, len(stats.Operations))
+	for op, opStats := range stats.Operations {
+		fields := make(map[string]dto.ShadowFieldStatsData, len(opStats.Fields))
+		for field, fieldStats := range opStats.Fields {
+			fields[field] = dto.ShadowFieldStatsData{
+				Compared:    fieldStats.Compared,
+				Diverged:    fieldStats.Diverged,
+				MeanAbsDiff: fieldStats.MeanAbsDiff,
+				MaxAbsDiff:  fieldStats.MaxAbsDiff,
+			}
+		}
+		operations[op] = dto.ShadowOperationStatsData{
+			Sampled:         opStats.Sampled,
+			Dropped:         opStats.Dropped,
+			CandidateErrors: opStats.CandidateErrors,
+			Compared:        opStats.Compared,
+			Divergent:       opStats.Divergent,
+			Fields:          fields,
+		}
+	}
+
+	return &dto.ShadowStatsData{
+		Enabled:       true,
+		Candidate:     stats.Candidate,
+		SamplePercent: stats.SamplePercent,
+		Operations:    operations,
+	}
+}
+
+// toQuotaUsageData maps upstream quota usage to the response DTO.
+func toQuotaUsageData(usage []entity.QuotaUsage) *dto.QuotaUsageData {
+	endpoints := make([]dto.QuotaEndpointUsageData, len(usage))
+	for i, endpoint := range usage {
+		endpoints[i] = dto.QuotaEndpointUsageData{
+			Endpoint: endpoint.Endpoint,
+			Minute:   toQuotaWindowData(endpoint.Minute),
+			Day:      toQuotaWindowData(endpoint.Day),
+			Rejected: endpoint.Rejected,
+		}
+	}
+
+	return &dto.QuotaUsageData{Enabled: true, Endpoints: endpoints}
+}
+
+// toQuotaWindowData maps one quota window; remaining is left out for unlimited windows.
+func toQuotaWindowData(window entity.QuotaWindow) dto.QuotaWindowData {
+	data := dto.QuotaWindowData{Used: window.Used, Limit: window.Limit, ResetAt: window.ResetAt}
+	if remaining := window.Remaining(); remaining >= 0 {
+		data.Remaining = &remaining
+	}
+	return data
+}
+
+// toCircuitBreakersData maps circuit breaker statuses to the response DTO.
+func toCircuitBreakersData(statuses []entity.CircuitBreakerStatus) []dto.CircuitBreakerData {
+	data := make([]dto.CircuitBreakerData, len(statuses))
+	for i, status := range statuses {
+		data[i] = dto.CircuitBreakerData{
+			Name:                status.Name,
+			State:               status.State,
+			Requests:            status.Requests,
+			Successes:           status.Successes,
+			Failures:            status.Failures,
+			ConsecutiveFailures: status.ConsecutiveFailures,
+			Rejected:            status.Rejected,
+		}
+	}
+	return data
+}
+
+// toReadinessData maps a readiness report to the response DTO.
+func toReadinessData(report entity.HealthReport) *dto.ReadinessData {
+	data := &dto.ReadinessData{Status: "ready", Checks: make([]dto.HealthCheckData, len(report.Checks))}
+	switch {
+	case !report.Ready:
+		data.Status = "not_ready"
+	case report.Degraded:
+		data.Status = "degraded"
+	}
+	for i, check := range report.Checks {
+		data.Checks[i] = dto.HealthCheckData{
+			Name:      check.Name,
+			Status:    string(check.Status),
+			Error:     check.Error,
+			LatencyMs: float64(check.Latency.Microseconds()) / 1000,
+			CheckedAt: check.CheckedAt,
+			Cached:    check.Cached,
+		}
+	}
+	return data
+}
+
+// toAPIKeyData maps an API key to its response DTO, deriving its status at now. The hash is left out.
+func toAPIKeyData(key entity.APIKey, now time.Time) dto.APIKeyData {
+	scopes := make([]string, len(key.Scopes))
+	for i, scope := range key.Scopes {
+		scopes[i] = string(scope)
+	}
+
+	status := "active"
+	switch {
+	case key.Revoked():
+		status = "revoked"
+	case key.Expired(now):
+		status = "expired"
+	}
+
+	return dto.APIKeyData{
+		ID:        key.ID,
+		Name:      key.Name,
+		Prefix:    key.Prefix,
+		Scopes:    scopes,
+		CreatedAt: key.CreatedAt,
+		ExpiresAt: key.ExpiresAt,
+		RevokedAt: key.RevokedAt,
+		Status:    status,
+	}
+}
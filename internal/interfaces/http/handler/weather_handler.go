@@ -1,11 +1,17 @@
 package handler
 
 import (
+	"fmt"
 	"net/http"
+	"strings"
+	"time"
 
+	"weather-api/internal/core/domain/entity"
 	"weather-api/internal/core/service"
 	"weather-api/internal/dto"
 	"weather-api/internal/infrastructure/support"
+	"weather-api/pkg/language"
+	"weather-api/pkg/units"
 
 	"github.com/gin-gonic/gin"
 )
@@ -30,11 +36,14 @@ func NewWeatherHandler(weatherService service.WeatherServiceInterface) *WeatherH
 // @Tags         Weather
 // @Accept       json
 // @Produce      json
-// @Param        city  path      string  true  "City name"
+// @Param        city   path      string  true   "City name"
+// @Param        units  query     string  false  "Unit system: metric (default), imperial or standard"
+// @Param        lang   query     string  false  "Description language, e.g. de or pt_br (default: best match from Accept-Language, then en)"
 // @Success      200  {object}  dto.WeatherResponse  "Successfully retrieved weather data"
 // @Failure      400  {object}  dto.WeatherResponse  "Invalid request (e.g., city name is missing)"
 // @Failure      404  {object}  dto.WeatherResponse  "Weather data not found for the specified city"
 // @Failure      500  {object}  dto.WeatherResponse  "Internal server error"
+// @Security     ApiKeyAuth
 // @Router       /weather/{city} [get]
 func (h *WeatherHandler) GetWeatherByCity(c *gin.Context) {
 	// Bind and validate path parameter using URI binding
@@ -47,8 +56,19 @@ func (h *WeatherHandler) GetWeatherByCity(c *gin.Context) {
 		return
 	}
 
+	system, err := parseUnits(c.Query("units"))
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+	lang, err := resolveLanguage(c)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
 	// Call the core service, which returns a pure domain model or an error.
-	weather, err := h.weatherService.GetWeatherByCity(params.City)
+	weather, err := h.weatherService.GetWeatherByCity(c.Request.Context(), params.City, system, lang)
 	if err != nil {
 		writeError(c, err)
 		return
@@ -64,6 +84,10 @@ func (h *WeatherHandler) GetWeatherByCity(c *gin.Context) {
 			Humidity:    weather.Humidity,
 			WindSpeed:   weather.WindSpeed,
 			Timestamp:   weather.Timestamp,
+			Units:       string(weather.Units),
+			Lang:        string(weather.Language),
+			Provider:    weather.Provider,
+			Consensus:   toWeatherConsensusData(weather.Consensus),
 		},
 	}
 
@@ -76,18 +100,22 @@ func (h *WeatherHandler) GetWeatherByCity(c *gin.Context) {
 // @Tags         Weather
 // @Accept       json
 // @Produce      json
-// @Param        lat  query      number  true  "Lat"
-// @Param        lon  query      number  true  "Lon"
+// @Param        lat    query      number  true   "Lat"
+// @Param        lon    query      number  true   "Lon"
+// @Param        units  query      string  false  "Unit system: metric (default), imperial or standard"
 // @Success      200  {object}  dto.WeatherOverviewResponse  "Successfully retrieved weather data"
 // @Failure      400  {object}  dto.WeatherOverviewResponse  "Invalid request (e.g., city name is missing)"
 // @Failure      404  {object}  dto.WeatherOverviewResponse  "Weather data not found for the specified city"
 // @Failure      500  {object}  dto.WeatherOverviewResponse  "Internal server error"
+// @Failure      501  {object}  dto.WeatherOverviewResponse  "Not supported by the configured weather provider"
+// @Security     ApiKeyAuth
 // @Router       /weather/overview [get]
 func (h *WeatherHandler) GetWeatherOverviewByLatLong(c *gin.Context) {
 	// Bind and validate query parameters with ranges
 	var input struct {
-		Lon float32 `form:"lon" binding:"required,gte=-180,lte=180"`
-		Lat float32 `form:"lat" binding:"required,gte=-90,lte=90"`
+		Lon   *float32 `form:"lon" binding:"required,gte=-180,lte=180"`
+		Lat   *float32 `form:"lat" binding:"required,gte=-90,lte=90"`
+		Units string   `form:"units"`
 	}
 
 	if err := c.ShouldBindQuery(&input); err != nil {
@@ -95,8 +123,14 @@ func (h *WeatherHandler) GetWeatherOverviewByLatLong(c *gin.Context) {
 		return
 	}
 
+	system, err := parseUnits(input.Units)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
 	// Call the core service, which returns a pure domain model or an error.
-	weatherOverview, err := h.weatherService.GetWeatherOverviewByLatLong(input.Lon, input.Lat)
+	weatherOverview, err := h.weatherService.GetWeatherOverviewByLatLong(c.Request.Context(), *input.Lon, *input.Lat, system)
 	if err != nil {
 		writeError(c, err)
 		return
@@ -112,15 +146,453 @@ func (h *WeatherHandler) GetWeatherOverviewByLatLong(c *gin.Context) {
 			Date:            weatherOverview.Date,
 			Units:           weatherOverview.Units,
 			WeatherOverview: weatherOverview.WeatherOverview,
+			Provider:        weatherOverview.Provider,
 		},
 	}
 
 	c.JSON(http.StatusOK, response)
 }
 
+// GetForecastByCity godoc
+// @Summary      Get forecast by city
+// @Description  Retrieves the 5 day forecast in 3 hour slots for a given city name, ordered by time.
+// @Tags         Weather
+// @Accept       json
+// @Produce      json
+// @Param        city   path      string  true   "City name"
+// @Param        units  query     string  false  "Unit system: metric (default), imperial or standard"
+// @Param        lang   query     string  false  "Description language, e.g. de or pt_br (default: best match from Accept-Language, then en)"
+// @Success      200  {object}  dto.ForecastResponse  "Successfully retrieved forecast data"
+// @Failure      400  {object}  dto.ForecastResponse  "Invalid request (e.g., city name is missing)"
+// @Failure      404  {object}  dto.ForecastResponse  "Forecast not found for the specified city"
+// @Failure      500  {object}  dto.ForecastResponse  "Internal server error"
+// @Security     ApiKeyAuth
+// @Router       /weather/{city}/forecast [get]
+func (h *WeatherHandler) GetForecastByCity(c *gin.Context) {
+	type cityURI struct {
+		City string `uri:"city" binding:"required,alphaunicode,min=2"`
+	}
+	var params cityURI
+	if err := c.ShouldBindUri(&params); err != nil {
+		writeError(c, support.NewErrBadRequest(err.Error()))
+		return
+	}
+
+	system, err := parseUnits(c.Query("units"))
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+	lang, err := resolveLanguage(c)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	forecast, err := h.weatherService.GetForecastByCity(c.Request.Context(), params.City, system, lang)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	slots := make([]dto.ForecastSlotData, 0, len(forecast.Slots))
+	for _, slot := range forecast.Slots {
+		slots = append(slots, dto.ForecastSlotData{
+			Time:                     slot.Time,
+			Temperature:              slot.Temperature,
+			FeelsLike:                slot.FeelsLike,
+			TempMin:                  slot.TempMin,
+			TempMax:                  slot.TempMax,
+			Description:              slot.Description,
+			Humidity:                 slot.Humidity,
+			WindSpeed:                slot.WindSpeed,
+			PrecipitationProbability: slot.PrecipitationProbability,
+		})
+	}
+
+	c.JSON(http.StatusOK, dto.ForecastResponse{
+		Success: true,
+		Data: &dto.ForecastData{
+			City:     forecast.City,
+			Country:  forecast.Country,
+			Lat:      forecast.Lat,
+			Lon:      forecast.Lon,
+			Slots:    slots,
+			Units:    string(forecast.Units),
+			Lang:     string(forecast.Language),
+			Provider: forecast.Provider,
+		},
+	})
+}
+
+// GetOneCall godoc
+// @Summary      Get One Call weather data by Lat Lon
+// @Description  Retrieves current, minutely, hourly, daily and alert blocks for a coordinate. Use include to request only some blocks.
+// @Tags         Weather
+// @Accept       json
+// @Produce      json
+// @Param        lat      query     number  true   "Lat"
+// @Param        lon      query     number  true   "Lon"
+// @Param        include  query     string  false  "Comma separated blocks: current,minutely,hourly,daily,alerts (default: all)"
+// @Param        units    query     string  false  "Unit system: metric (default), imperial or standard"
+// @Param        lang     query     string  false  "Description language, e.g. de or pt_br (default: best match from Accept-Language, then en)"
+// @Success      200  {object}  dto.OneCallResponse  "Successfully retrieved weather data"
+// @Failure      400  {object}  dto.OneCallResponse  "Invalid request (e.g., unknown include block)"
+// @Failure      404  {object}  dto.OneCallResponse  "Weather data not found for the specified location"
+// @Failure      500  {object}  dto.OneCallResponse  "Internal server error"
+// @Failure      501  {object}  dto.OneCallResponse  "Not supported by the configured weather provider"
+// @Security     ApiKeyAuth
+// @Router       /weather/onecall [get]
+func (h *WeatherHandler) GetOneCall(c *gin.Context) {
+	var input struct {
+		Lat     *float32 `form:"lat" binding:"required,gte=-90,lte=90"`
+		Lon     *float32 `form:"lon" binding:"required,gte=-180,lte=180"`
+		Include string   `form:"include"`
+		Units   string   `form:"units"`
+	}
+
+	if err := c.ShouldBindQuery(&input); err != nil {
+		writeError(c, support.NewErrBadRequest(err.Error()))
+		return
+	}
+
+	include, err := parseIncludeBlocks(input.Include)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	system, err := parseUnits(input.Units)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+	lang, err := resolveLanguage(c)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	oneCall, err := h.weatherService.GetOneCall(c.Request.Context(), *input.Lat, *input.Lon, include, system, lang)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.OneCallResponse{
+		Success: true,
+		Data:    toOneCallData(oneCall),
+	})
+}
+
+// parseIncludeBlocks parses a comma separated list of One Call block names.
+func parseIncludeBlocks(raw string) ([]entity.OneCallBlock, error) {
+	if strings.TrimSpace(raw) == "" {
+		return nil, nil
+	}
+
+	var blocks []entity.OneCallBlock
+	for _, name := range strings.Split(raw, ",") {
+		block, ok := entity.ParseOneCallBlock(name)
+		if !ok {
+			return nil, support.NewErrBadRequest(fmt.Sprintf("unknown include block '%s'", strings.TrimSpace(name)))
+		}
+		blocks = append(blocks, block)
+	}
+	return blocks, nil
+}
+
+// parseUnits parses the units query parameter; an empty value selects metric.
+func parseUnits(raw string) (units.System, error) {
+	system, err := units.Parse(raw)
+	if err != nil {
+		return "", support.NewErrBadRequest(err.Error())
+	}
+	return system, nil
+}
+
+// resolveLanguage returns the language named by the lang query parameter or, when it is absent,
+// the best supported match from the Accept-Language header. An unsupported lang is a bad request.
+func resolveLanguage(c *gin.Context) (language.Code, error) {
+	raw := c.Query("lang")
+	if raw == "" {
+		return language.Negotiate(c.GetHeader("Accept-Language")), nil
+	}
+
+	lang, err := language.Parse(raw)
+	if err != nil {
+		return "", support.NewErrBadRequest(err.Error())
+	}
+	return lang, nil
+}
+
+// GetAirQuality godoc
+// @Summary      Get current air quality by Lat Lon
+// @Description  Retrieves the current air quality index and pollutant concentrations (PM2.5, PM10, O3, NO2, SO2, CO) for a coordinate.
+// @Tags         Air Quality
+// @Accept       json
+// @Produce      json
+// @Param        lat  query      number  true  "Lat"
+// @Param        lon  query      number  true  "Lon"
+// @Success      200  {object}  dto.AirQualityResponse  "Successfully retrieved air quality data"
+// @Failure      400  {object}  dto.AirQualityResponse  "Invalid request (e.g., lat out of range)"
+// @Failure      404  {object}  dto.AirQualityResponse  "Air quality data not found for the specified location"
+// @Failure      500  {object}  dto.AirQualityResponse  "Internal server error"
+// @Security     ApiKeyAuth
+// @Router       /weather/air-quality [get]
+func (h *WeatherHandler) GetAirQuality(c *gin.Context) {
+	var input struct {
+		Lat *float32 `form:"lat" binding:"required,gte=-90,lte=90"`
+		Lon *float32 `form:"lon" binding:"required,gte=-180,lte=180"`
+	}
+
+	if err := c.ShouldBindQuery(&input); err != nil {
+		writeError(c, support.NewErrBadRequest(err.Error()))
+		return
+	}
+
+	airQuality, err := h.weatherService.GetAirQuality(c.Request.Context(), *input.Lat, *input.Lon)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.AirQualityResponse{Success: true, Data: toAirQualityData(airQuality)})
+}
+
+// GetAirQualityForecast godoc
+// @Summary      Get air quality forecast by Lat Lon
+// @Description  Retrieves the hourly air quality forecast for the next days for a coordinate.
+// @Tags         Air Quality
+// @Accept       json
+// @Produce      json
+// @Param        lat  query      number  true  "Lat"
+// @Param        lon  query      number  true  "Lon"
+// @Success      200  {object}  dto.AirQualityResponse  "Successfully retrieved air quality forecast"
+// @Failure      400  {object}  dto.AirQualityResponse  "Invalid request (e.g., lat out of range)"
+// @Failure      404  {object}  dto.AirQualityResponse  "Air quality data not found for the specified location"
+// @Failure      500  {object}  dto.AirQualityResponse  "Internal server error"
+// @Security     ApiKeyAuth
+// @Router       /weather/air-quality/forecast [get]
+func (h *WeatherHandler) GetAirQualityForecast(c *gin.Context) {
+	var input struct {
+		Lat *float32 `form:"lat" binding:"required,gte=-90,lte=90"`
+		Lon *float32 `form:"lon" binding:"required,gte=-180,lte=180"`
+	}
+
+	if err := c.ShouldBindQuery(&input); err != nil {
+		writeError(c, support.NewErrBadRequest(err.Error()))
+		return
+	}
+
+	airQuality, err := h.weatherService.GetAirQualityForecast(c.Request.Context(), *input.Lat, *input.Lon)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.AirQualityResponse{Success: true, Data: toAirQualityData(airQuality)})
+}
+
+// GetAirQualityHistory godoc
+// @Summary      Get historical air quality by Lat Lon
+// @Description  Retrieves hourly air quality readings between start and end (RFC3339) for a coordinate.
+// @Tags         Air Quality
+// @Accept       json
+// @Produce      json
+// @Param        lat    query      number  true  "Lat"
+// @Param        lon    query      number  true  "Lon"
+// @Param        start  query      string  true  "Range start (RFC3339)"
+// @Param        end    query      string  true  "Range end (RFC3339)"
+// @Success      200  {object}  dto.AirQualityResponse  "Successfully retrieved air quality history"
+// @Failure      400  {object}  dto.AirQualityResponse  "Invalid request (e.g., end before start)"
+// @Failure      404  {object}  dto.AirQualityResponse  "Air quality data not found for the specified location"
+// @Failure      500  {object}  dto.AirQualityResponse  "Internal server error"
+// @Security     ApiKeyAuth
+// @Router       /weather/air-quality/history [get]
+func (h *WeatherHandler) GetAirQualityHistory(c *gin.Context) {
+	var input struct {
+		Lat   *float32  `form:"lat" binding:"required,gte=-90,lte=90"`
+		Lon   *float32  `form:"lon" binding:"required,gte=-180,lte=180"`
+		Start time.Time `form:"start" binding:"required" time_format:"2006-01-02T15:04:05Z07:00"`
+		End   time.Time `form:"end" binding:"required" time_format:"2006-01-02T15:04:05Z07:00"`
+	}
+
+	if err := c.ShouldBindQuery(&input); err != nil {
+		writeError(c, support.NewErrBadRequest(err.Error()))
+		return
+	}
+	if !input.End.After(input.Start) {
+		writeError(c, support.NewErrBadRequest("end must be after start"))
+		return
+	}
+
+	airQuality, err := h.weatherService.GetAirQualityHistory(c.Request.Context(), *input.Lat, *input.Lon, input.Start, input.End)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.AirQualityResponse{Success: true, Data: toAirQualityData(airQuality)})
+}
+
+// GetHistoricalWeather godoc
+// @Summary      Get historical weather at a timestamp
+// @Description  Retrieves the weather recorded at a coordinate at a past point in time.
+// @Tags         History
+// @Accept       json
+// @Produce      json
+// @Param        lat  query      number  true  "Lat"
+// @Param        lon  query      number  true  "Lon"
+// @Param        at     query      string  true   "Timestamp (RFC3339), e.g. 2024-01-15T14:00:00Z"
+// @Param        units  query      string  false  "Unit system: metric (default), imperial or standard"
+// @Param        lang   query      string  false  "Description language, e.g. de or pt_br (default: best match from Accept-Language, then en)"
+// @Success      200  {object}  dto.HistoricalWeatherResponse  "Successfully retrieved historical weather"
+// @Failure      400  {object}  dto.HistoricalWeatherResponse  "Invalid request (e.g., at is not RFC3339)"
+// @Failure      404  {object}  dto.HistoricalWeatherResponse  "No history for the specified location"
+// @Failure      500  {object}  dto.HistoricalWeatherResponse  "Internal server error"
+// @Security     ApiKeyAuth
+// @Router       /weather/history [get]
+func (h *WeatherHandler) GetHistoricalWeather(c *gin.Context) {
+	var input struct {
+		Lat   *float32  `form:"lat" binding:"required,gte=-90,lte=90"`
+		Lon   *float32  `form:"lon" binding:"required,gte=-180,lte=180"`
+		At    time.Time `form:"at" binding:"required" time_format:"2006-01-02T15:04:05Z07:00"`
+		Units string    `form:"units"`
+	}
+
+	if err := c.ShouldBindQuery(&input); err != nil {
+		writeError(c, support.NewErrBadRequest(err.Error()))
+		return
+	}
+
+	system, err := parseUnits(input.Units)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+	lang, err := resolveLanguage(c)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	historical, err := h.weatherService.GetHistoricalWeather(c.Request.Context(), *input.Lat, *input.Lon, input.At, system, lang)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.HistoricalWeatherResponse{Success: true, Data: toHistoricalWeatherData(historical)})
+}
+
+// GetHistoricalRange godoc
+// @Summary      Get historical weather over a date range
+// @Description  Retrieves one day summary per day between from and to (inclusive, at most 31 days) with range totals.
+// @Tags         History
+// @Accept       json
+// @Produce      json
+// @Param        lat   query      number  true  "Lat"
+// @Param        lon   query      number  true  "Lon"
+// @Param        from  query      string  true  "First day (YYYY-MM-DD)"
+// @Param        to     query      string  true   "Last day (YYYY-MM-DD)"
+// @Param        units  query      string  false  "Unit system: metric (default), imperial or standard"
+// @Success      200  {object}  dto.HistoricalRangeResponse  "Successfully retrieved historical range"
+// @Failure      400  {object}  dto.HistoricalRangeResponse  "Invalid request (e.g., range too long)"
+// @Failure      404  {object}  dto.HistoricalRangeResponse  "No history for the specified location"
+// @Failure      500  {object}  dto.HistoricalRangeResponse  "Internal server error"
+// @Security     ApiKeyAuth
+// @Router       /weather/history/range [get]
+func (h *WeatherHandler) GetHistoricalRange(c *gin.Context) {
+	var input struct {
+		Lat   *float32  `form:"lat" binding:"required,gte=-90,lte=90"`
+		Lon   *float32  `form:"lon" binding:"required,gte=-180,lte=180"`
+		From  time.Time `form:"from" binding:"required" time_format:"2006-01-02" time_utc:"1"`
+		To    time.Time `form:"to" binding:"required" time_format:"2006-01-02" time_utc:"1"`
+		Units string    `form:"units"`
+	}
+
+	if err := c.ShouldBindQuery(&input); err != nil {
+		writeError(c, support.NewErrBadRequest(err.Error()))
+		return
+	}
+	if days := service.HistoryRangeDays(input.From, input.To); days < 1 || days > service.MaxHistoryRangeDays {
+		writeError(c, support.NewErrBadRequest(fmt.Sprintf("range must span between 1 and %d days", service.MaxHistoryRangeDays)))
+		return
+	}
+
+	system, err := parseUnits(input.Units)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	historyRange, err := h.weatherService.GetHistoricalRange(c.Request.Context(), *input.Lat, *input.Lon, input.From, input.To, system)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.HistoricalRangeResponse{Success: true, Data: toHistoricalRangeData(historyRange)})
+}
+
+// GetAlerts godoc
+// @Summary      Get weather alerts by Lat Lon
+// @Description  Retrieves national agency alerts for a coordinate with a normalized severity, most severe first.
+// @Tags         Alerts
+// @Accept       json
+// @Produce      json
+// @Param        lat           query     number  true   "Lat"
+// @Param        lon           query     number  true   "Lon"
+// @Param        min_severity  query     string  false  "Minimum severity: unknown, minor, moderate, severe, extreme (default: unknown)"
+// @Param        active_at     query     string  false  "Only alerts in effect at this time (RFC3339)"
+// @Success      200  {object}  dto.AlertsResponse  "Successfully retrieved alerts (possibly empty)"
+// @Failure      400  {object}  dto.AlertsResponse  "Invalid request (e.g., unknown min_severity)"
+// @Failure      404  {object}  dto.AlertsResponse  "Weather data not found for the specified location"
+// @Failure      500  {object}  dto.AlertsResponse  "Internal server error"
+// @Failure      501  {object}  dto.AlertsResponse  "Not supported by the configured weather provider"
+// @Security     ApiKeyAuth
+// @Router       /weather/alerts [get]
+func (h *WeatherHandler) GetAlerts(c *gin.Context) {
+	var input struct {
+		Lat         *float32  `form:"lat" binding:"required,gte=-90,lte=90"`
+		Lon         *float32  `form:"lon" binding:"required,gte=-180,lte=180"`
+		MinSeverity string    `form:"min_severity"`
+		ActiveAt    time.Time `form:"active_at" time_format:"2006-01-02T15:04:05Z07:00"`
+	}
+
+	if err := c.ShouldBindQuery(&input); err != nil {
+		writeError(c, support.NewErrBadRequest(err.Error()))
+		return
+	}
+
+	minSeverity := entity.AlertSeverityUnknown
+	if input.MinSeverity != "" {
+		severity, ok := entity.ParseAlertSeverity(input.MinSeverity)
+		if !ok {
+			writeError(c, support.NewErrBadRequest(fmt.Sprintf("unknown min_severity '%s'", input.MinSeverity)))
+			return
+		}
+		minSeverity = severity
+	}
+
+	alerts, err := h.weatherService.GetAlerts(c.Request.Context(), *input.Lat, *input.Lon, minSeverity, input.ActiveAt)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	data := toWeatherAlertData(alerts)
+	if data == nil {
+		data = []dto.WeatherAlertData{}
+	}
+
+	c.JSON(http.StatusOK, dto.AlertsResponse{Success: true, Data: data})
+}
+
 // HealthCheck godoc
 // @Summary      Service Health Check
-// @Description  Checks if the weather service is up and running.
+// @Description  Checks if the weather service is up and running. Kept for compatibility; use /livez and /readyz for orchestrator probes.
 // @Tags         Health
 // @Accept       json
 // @Produce      json
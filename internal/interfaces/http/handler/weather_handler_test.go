@@ -1,14 +1,19 @@
 package handler
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"weather-api/internal/core/domain/entity"
 	"weather-api/internal/dto"
 	"weather-api/internal/infrastructure/support"
+	"weather-api/pkg/circuitbreaker"
+	"weather-api/pkg/language"
+	"weather-api/pkg/units"
 
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
@@ -20,22 +25,94 @@ type MockWeatherService struct {
 	mock.Mock
 }
 
-func (m *MockWeatherService) GetWeatherByCity(city string) (*entity.Weather, error) {
-	args := m.Called(city)
+func (m *MockWeatherService) GetWeatherByCity(ctx context.Context, city string, system units.System, lang language.Code) (*entity.Weather, error) {
+	args := m.Called(city, system, lang)
 	if w := args.Get(0); w != nil {
 		return w.(*entity.Weather), args.Error(1)
 	}
 	return nil, args.Error(1)
 }
 
-func (m *MockWeatherService) GetWeatherOverviewByLatLong(lon float32, lat float32) (*entity.WeatherOverview, error) {
-	args := m.Called(lon, lat)
+func (m *MockWeatherService) GetWeatherByCoordinates(ctx context.Context, lat float32, lon float32, system units.System, lang language.Code) (*entity.Weather, error) {
+	args := m.Called(lat, lon, system, lang)
+	if w := args.Get(0); w != nil {
+		return w.(*entity.Weather), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockWeatherService) GetWeatherOverviewByLatLong(ctx context.Context, lon float32, lat float32, system units.System) (*entity.WeatherOverview, error) {
+	args := m.Called(lon, lat, system)
 	if w := args.Get(0); w != nil {
 		return w.(*entity.WeatherOverview), args.Error(1)
 	}
 	return nil, args.Error(1)
 }
 
+func (m *MockWeatherService) GetForecastByCity(ctx context.Context, city string, system units.System, lang language.Code) (*entity.Forecast, error) {
+	args := m.Called(city, system, lang)
+	if f := args.Get(0); f != nil {
+		return f.(*entity.Forecast), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockWeatherService) GetOneCall(ctx context.Context, lat float32, lon float32, include []entity.OneCallBlock, system units.System, lang language.Code) (*entity.OneCall, error) {
+	args := m.Called(lat, lon, include, system, lang)
+	if o := args.Get(0); o != nil {
+		return o.(*entity.OneCall), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockWeatherService) GetAirQuality(ctx context.Context, lat float32, lon float32) (*entity.AirQuality, error) {
+	args := m.Called(lat, lon)
+	if a := args.Get(0); a != nil {
+		return a.(*entity.AirQuality), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockWeatherService) GetAirQualityForecast(ctx context.Context, lat float32, lon float32) (*entity.AirQuality, error) {
+	args := m.Called(lat, lon)
+	if a := args.Get(0); a != nil {
+		return a.(*entity.AirQuality), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockWeatherService) GetAirQualityHistory(ctx context.Context, lat float32, lon float32, start time.Time, end time.Time) (*entity.AirQuality, error) {
+	args := m.Called(lat, lon, start, end)
+	if a := args.Get(0); a != nil {
+		return a.(*entity.AirQuality), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockWeatherService) GetHistoricalWeather(ctx context.Context, lat float32, lon float32, at time.Time, system units.System, lang language.Code) (*entity.HistoricalWeather, error) {
+	args := m.Called(lat, lon, at, system, lang)
+	if h := args.Get(0); h != nil {
+		return h.(*entity.HistoricalWeather), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockWeatherService) GetHistoricalRange(ctx context.Context, lat float32, lon float32, from time.Time, to time.Time, system units.System) (*entity.HistoricalRange, error) {
+	args := m.Called(lat, lon, from, to, system)
+	if r := args.Get(0); r != nil {
+		return r.(*entity.HistoricalRange), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockWeatherService) GetAlerts(ctx context.Context, lat float32, lon float32, minSeverity entity.AlertSeverity, activeAt time.Time) ([]entity.WeatherAlert, error) {
+	args := m.Called(lat, lon, minSeverity, activeAt)
+	if a := args.Get(0); a != nil {
+		return a.([]entity.WeatherAlert), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
 func TestWeatherHandler_GetWeatherByCity_Success(t *testing.T) {
 	// Arrange
 	gin.SetMode(gin.TestMode)
@@ -50,12 +127,13 @@ func TestWeatherHandler_GetWeatherByCity_Success(t *testing.T) {
 		WindSpeed:   10.5,
 	}
 
-	mockService.On("GetWeatherByCity", "Istanbul").Return(expectedWeather, nil)
+	mockService.On("GetWeatherByCity", "Istanbul", units.Metric, language.English).Return(expectedWeather, nil)
 
 	// Create test request
 	w := httptest.NewRecorder()
 	c, _ := gin.CreateTestContext(w)
 	c.Params = gin.Params{{Key: "city", Value: "Istanbul"}}
+	c.Request = httptest.NewRequest(http.MethodGet, "/weather/Istanbul", nil)
 
 	// Act
 	handler.GetWeatherByCity(c)
@@ -73,6 +151,177 @@ func TestWeatherHandler_GetWeatherByCity_Success(t *testing.T) {
 	mockService.AssertExpectations(t)
 }
 
+func TestWeatherHandler_GetWeatherByCity_Consensus(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	mockService := new(MockWeatherService)
+	handler := NewWeatherHandler(mockService)
+
+	mockService.On("GetWeatherByCity", "Istanbul", units.Metric, language.English).Return(&entity.Weather{
+		City:        "Istanbul",
+		Temperature: 25.5,
+		Provider:    "consensus",
+		Consensus: &entity.WeatherConsensus{
+			Strategy:          "median",
+			Providers:         []string{"openweather", "openmeteo"},
+			TemperatureSpread: 0.8,
+		},
+	}, nil)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Params = gin.Params{{Key: "city", Value: "Istanbul"}}
+	c.Request = httptest.NewRequest(http.MethodGet, "/weather/Istanbul", nil)
+
+	// Act
+	handler.GetWeatherByCity(c)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response dto.WeatherResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, "consensus", response.Data.Provider)
+	assert.Equal(t, []string{"openweather", "openmeteo"}, response.Data.Consensus.Providers)
+	assert.Equal(t, 0.8, response.Data.Consensus.TemperatureSpread)
+
+	mockService.AssertExpectations(t)
+}
+
+func TestWeatherHandler_GetWeatherByCity_Imperial(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	mockService := new(MockWeatherService)
+	handler := NewWeatherHandler(mockService)
+
+	mockService.On("GetWeatherByCity", "Istanbul", units.Imperial, language.English).Return(&entity.Weather{
+		City:        "Istanbul",
+		Temperature: 77.9,
+		Units:       units.Imperial,
+	}, nil)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Params = gin.Params{{Key: "city", Value: "Istanbul"}}
+	c.Request = httptest.NewRequest(http.MethodGet, "/weather/Istanbul?units=Imperial", nil)
+
+	// Act
+	handler.GetWeatherByCity(c)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response dto.WeatherResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, "imperial", response.Data.Units)
+	assert.Equal(t, 77.9, response.Data.Temperature)
+
+	mockService.AssertExpectations(t)
+}
+
+func TestWeatherHandler_GetWeatherByCity_InvalidUnits(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	mockService := new(MockWeatherService)
+	handler := NewWeatherHandler(mockService)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Params = gin.Params{{Key: "city", Value: "Istanbul"}}
+	c.Request = httptest.NewRequest(http.MethodGet, "/weather/Istanbul?units=kelvin", nil)
+
+	// Act
+	handler.GetWeatherByCity(c)
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	var response dto.WeatherResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, false, response.Success)
+	assert.Contains(t, response.Error, "kelvin")
+
+	mockService.AssertNotCalled(t, "GetWeatherByCity", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestWeatherHandler_GetWeatherByCity_AcceptLanguage(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	mockService := new(MockWeatherService)
+	handler := NewWeatherHandler(mockService)
+
+	mockService.On("GetWeatherByCity", "Berlin", units.Metric, language.Code("de")).Return(&entity.Weather{
+		City:        "Berlin",
+		Description: "leichter Regen",
+		Language:    "de",
+	}, nil)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Params = gin.Params{{Key: "city", Value: "Berlin"}}
+	c.Request = httptest.NewRequest(http.MethodGet, "/weather/Berlin", nil)
+	c.Request.Header.Set("Accept-Language", "de-DE,de;q=0.9,en;q=0.8")
+
+	// Act
+	handler.GetWeatherByCity(c)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response dto.WeatherResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, "de", response.Data.Lang)
+	assert.Equal(t, "leichter Regen", response.Data.Description)
+
+	mockService.AssertExpectations(t)
+}
+
+func TestWeatherHandler_GetWeatherByCity_LangOverridesHeader(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	mockService := new(MockWeatherService)
+	handler := NewWeatherHandler(mockService)
+
+	mockService.On("GetWeatherByCity", "Berlin", units.Metric, language.Code("fr")).
+		Return(&entity.Weather{City: "Berlin", Language: "fr"}, nil)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Params = gin.Params{{Key: "city", Value: "Berlin"}}
+	c.Request = httptest.NewRequest(http.MethodGet, "/weather/Berlin?lang=fr", nil)
+	c.Request.Header.Set("Accept-Language", "de")
+
+	// Act
+	handler.GetWeatherByCity(c)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestWeatherHandler_GetWeatherByCity_UnsupportedLang(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	mockService := new(MockWeatherService)
+	handler := NewWeatherHandler(mockService)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Params = gin.Params{{Key: "city", Value: "Berlin"}}
+	c.Request = httptest.NewRequest(http.MethodGet, "/weather/Berlin?lang=klingon", nil)
+
+	// Act
+	handler.GetWeatherByCity(c)
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockService.AssertNotCalled(t, "GetWeatherByCity", mock.Anything, mock.Anything, mock.Anything)
+}
+
 func TestWeatherHandler_GetWeatherByCity_EmptyCity(t *testing.T) {
 	// Arrange
 	gin.SetMode(gin.TestMode)
@@ -83,6 +332,7 @@ func TestWeatherHandler_GetWeatherByCity_EmptyCity(t *testing.T) {
 	w := httptest.NewRecorder()
 	c, _ := gin.CreateTestContext(w)
 	c.Params = gin.Params{{Key: "city", Value: ""}}
+	c.Request = httptest.NewRequest(http.MethodGet, "/weather/", nil)
 
 	// Act
 	handler.GetWeatherByCity(c)
@@ -103,12 +353,13 @@ func TestWeatherHandler_GetWeatherByCity_NotFound(t *testing.T) {
 	mockService := new(MockWeatherService)
 	handler := NewWeatherHandler(mockService)
 
-	mockService.On("GetWeatherByCity", "InvalidCity").Return(nil, support.NewErrNotFound("city not found"))
+	mockService.On("GetWeatherByCity", "InvalidCity", units.Metric, language.English).Return(nil, support.NewErrNotFound("city not found"))
 
 	// Create test request
 	w := httptest.NewRecorder()
 	c, _ := gin.CreateTestContext(w)
 	c.Params = gin.Params{{Key: "city", Value: "InvalidCity"}}
+	c.Request = httptest.NewRequest(http.MethodGet, "/weather/InvalidCity", nil)
 
 	// Act
 	handler.GetWeatherByCity(c)
@@ -124,6 +375,553 @@ func TestWeatherHandler_GetWeatherByCity_NotFound(t *testing.T) {
 	mockService.AssertExpectations(t)
 }
 
+func TestWeatherHandler_GetWeatherByCity_QuotaExhausted(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	mockService := new(MockWeatherService)
+	handler := NewWeatherHandler(mockService)
+
+	quotaErr := support.NewErrQuotaExceeded("openweather 2.5 per-minute quota of 60 calls exhausted, retry in 12s", 12*time.Second)
+	mockService.On("GetWeatherByCity", "London", units.Metric, language.English).Return(nil, quotaErr)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Params = gin.Params{{Key: "city", Value: "London"}}
+	c.Request = httptest.NewRequest(http.MethodGet, "/weather/London", nil)
+
+	// Act
+	handler.GetWeatherByCity(c)
+
+	// Assert
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	assert.Equal(t, "12", w.Header().Get("Retry-After"))
+	assert.JSONEq(t, `{"success": false, "error": "openweather 2.5 per-minute quota of 60 calls exhausted, retry in 12s"}`, w.Body.String())
+
+	mockService.AssertExpectations(t)
+}
+
+func TestWeatherHandler_GetWeatherByCity_UpstreamRejected(t *testing.T) {
+	tests := []struct {
+		name           string
+		err            error
+		wantStatus     int
+		wantRetryAfter string
+	}{
+		{"throttled", support.NewErrUpstreamRateLimited("upstream rate limit exceeded (429 Too Many Requests)", 30*time.Second), http.StatusServiceUnavailable, "30"},
+		{"key rejected", support.NewErrUpstreamUnauthorized("upstream rejected the API key (401 Unauthorized)"), http.StatusBadGateway, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			gin.SetMode(gin.TestMode)
+			mockService := new(MockWeatherService)
+			handler := NewWeatherHandler(mockService)
+			mockService.On("GetWeatherByCity", "London", units.Metric, language.English).Return(nil, tt.err)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Params = gin.Params{{Key: "city", Value: "London"}}
+			c.Request = httptest.NewRequest(http.MethodGet, "/weather/London", nil)
+
+			// Act
+			handler.GetWeatherByCity(c)
+
+			// Assert
+			assert.Equal(t, tt.wantStatus, w.Code)
+			assert.Equal(t, tt.wantRetryAfter, w.Header().Get("Retry-After"))
+			assert.JSONEq(t, `{"success": false, "error": "`+tt.err.Error()+`"}`, w.Body.String())
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestWeatherHandler_GetWeatherByCity_CircuitOpen(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	mockService := new(MockWeatherService)
+	handler := NewWeatherHandler(mockService)
+	mockService.On("GetWeatherByCity", "London", units.Metric, language.English).Return(nil, circuitbreaker.ErrOpenState)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Params = gin.Params{{Key: "city", Value: "London"}}
+	c.Request = httptest.NewRequest(http.MethodGet, "/weather/London", nil)
+
+	// Act
+	handler.GetWeatherByCity(c)
+
+	// Assert
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	assert.JSONEq(t, `{"success": false, "error": "circuit breaker is open"}`, w.Body.String())
+	mockService.AssertExpectations(t)
+}
+
+func TestWeatherHandler_GetWeatherByCity_ClientCanceled(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	mockService := new(MockWeatherService)
+	handler := NewWeatherHandler(mockService)
+	mockService.On("GetWeatherByCity", "London", units.Metric, language.English).Return(nil, context.Canceled)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Params = gin.Params{{Key: "city", Value: "London"}}
+	c.Request = httptest.NewRequest(http.MethodGet, "/weather/London", nil)
+
+	// Act
+	handler.GetWeatherByCity(c)
+	c.Writer.WriteHeaderNow()
+
+	// Assert
+	assert.Equal(t, 499, w.Code)
+	assert.Empty(t, w.Body.String())
+	assert.ErrorIs(t, c.Errors.Last(), context.Canceled)
+	mockService.AssertExpectations(t)
+}
+
+func TestWeatherHandler_GetForecastByCity_Success(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	mockService := new(MockWeatherService)
+	handler := NewWeatherHandler(mockService)
+
+	slotTime := time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)
+	mockService.On("GetForecastByCity", "London", units.Metric, language.English).Return(&entity.Forecast{
+		City:    "London",
+		Country: "GB",
+		Slots: []entity.ForecastSlot{
+			{Time: slotTime, Temperature: 7.5, Description: "light rain", PrecipitationProbability: 0.4},
+		},
+	}, nil)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Params = gin.Params{{Key: "city", Value: "London"}}
+	c.Request = httptest.NewRequest(http.MethodGet, "/weather/London/forecast", nil)
+
+	// Act
+	handler.GetForecastByCity(c)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response dto.ForecastResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.True(t, response.Success)
+	assert.Equal(t, "GB", response.Data.Country)
+	assert.Len(t, response.Data.Slots, 1)
+	assert.True(t, slotTime.Equal(response.Data.Slots[0].Time))
+	assert.Equal(t, 0.4, response.Data.Slots[0].PrecipitationProbability)
+
+	mockService.AssertExpectations(t)
+}
+
+func TestWeatherHandler_GetForecastByCity_InvalidCity(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	mockService := new(MockWeatherService)
+	handler := NewWeatherHandler(mockService)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Params = gin.Params{{Key: "city", Value: "1"}}
+	c.Request = httptest.NewRequest(http.MethodGet, "/weather/1/forecast", nil)
+
+	// Act
+	handler.GetForecastByCity(c)
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockService.AssertNotCalled(t, "GetForecastByCity", mock.Anything)
+}
+
+func TestWeatherHandler_GetForecastByCity_NotFound(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	mockService := new(MockWeatherService)
+	handler := NewWeatherHandler(mockService)
+
+	mockService.On("GetForecastByCity", "Nowhere", units.Metric, language.English).Return(nil, support.NewErrNotFound("city not found"))
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Params = gin.Params{{Key: "city", Value: "Nowhere"}}
+	c.Request = httptest.NewRequest(http.MethodGet, "/weather/Nowhere/forecast", nil)
+
+	// Act
+	handler.GetForecastByCity(c)
+
+	// Assert
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestWeatherHandler_GetOneCall_Success(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	mockService := new(MockWeatherService)
+	handler := NewWeatherHandler(mockService)
+
+	include := []entity.OneCallBlock{entity.OneCallHourly, entity.OneCallDaily}
+	mockService.On("GetOneCall", float32(51.5), float32(-0.12), include, units.Metric, language.English).Return(&entity.OneCall{
+		Timezone: "Europe/London",
+		Hourly:   []entity.HourlyConditions{{Temperature: 7.5}},
+		Daily:    []entity.DailyConditions{{TempMax: 9.1}},
+	}, nil)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/weather/onecall?lat=51.5&lon=-0.12&include=hourly,Daily", nil)
+
+	// Act
+	handler.GetOneCall(c)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response map[string]interface{}
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	data := response["data"].(map[string]interface{})
+	assert.Contains(t, data, "hourly")
+	assert.Contains(t, data, "daily")
+	assert.NotContains(t, data, "current")
+	assert.NotContains(t, data, "alerts")
+
+	mockService.AssertExpectations(t)
+}
+
+func TestWeatherHandler_GetOneCall_UnknownBlock(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	mockService := new(MockWeatherService)
+	handler := NewWeatherHandler(mockService)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/weather/onecall?lat=51.5&lon=-0.12&include=hourly,yearly", nil)
+
+	// Act
+	handler.GetOneCall(c)
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	var response dto.OneCallResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Contains(t, response.Error, "yearly")
+	mockService.AssertNotCalled(t, "GetOneCall", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestWeatherHandler_GetAirQuality_Success(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	mockService := new(MockWeatherService)
+	handler := NewWeatherHandler(mockService)
+
+	mockService.On("GetAirQuality", float32(51.5), float32(-0.12)).Return(&entity.AirQuality{
+		Lat: 51.5,
+		Lon: -0.12,
+		Readings: []entity.AirQualityReading{
+			{AQI: 3, Components: entity.AirComponents{PM2_5: 21.4, PM10: 30.2, O3: 60.1}},
+		},
+	}, nil)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/weather/air-quality?lat=51.5&lon=-0.12", nil)
+
+	// Act
+	handler.GetAirQuality(c)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response dto.AirQualityResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.True(t, response.Success)
+	assert.Len(t, response.Data.Readings, 1)
+	assert.Equal(t, "moderate", response.Data.Readings[0].AQILabel)
+	assert.Equal(t, 21.4, response.Data.Readings[0].Components.PM2_5)
+
+	mockService.AssertExpectations(t)
+}
+
+func TestWeatherHandler_GetAirQuality_Coordinates(t *testing.T) {
+	tests := []struct {
+		name       string
+		query      string
+		lat, lon   float32
+		wantStatus int
+	}{
+		{"equator", "lat=0&lon=10", 0, 10, http.StatusOK},
+		{"prime meridian", "lat=51.48&lon=0", 51.48, 0, http.StatusOK},
+		{"null island", "lat=0&lon=0", 0, 0, http.StatusOK},
+		{"missing lat", "lon=10", 0, 0, http.StatusBadRequest},
+		{"lat out of range", "lat=91&lon=10", 0, 0, http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			gin.SetMode(gin.TestMode)
+			mockService := new(MockWeatherService)
+			handler := NewWeatherHandler(mockService)
+			if tt.wantStatus == http.StatusOK {
+				mockService.On("GetAirQuality", tt.lat, tt.lon).Return(&entity.AirQuality{Lat: tt.lat, Lon: tt.lon}, nil)
+			}
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodGet, "/weather/air-quality?"+tt.query, nil)
+
+			// Act
+			handler.GetAirQuality(c)
+
+			// Assert
+			assert.Equal(t, tt.wantStatus, w.Code)
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestWeatherHandler_GetAirQualityHistory_InvalidRange(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	mockService := new(MockWeatherService)
+	handler := NewWeatherHandler(mockService)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet,
+		"/weather/air-quality/history?lat=51.5&lon=-0.12&start=2024-01-02T00:00:00Z&end=2024-01-01T00:00:00Z", nil)
+
+	// Act
+	handler.GetAirQualityHistory(c)
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockService.AssertNotCalled(t, "GetAirQualityHistory", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestWeatherHandler_GetAirQualityHistory_Success(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	mockService := new(MockWeatherService)
+	handler := NewWeatherHandler(mockService)
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	mockService.On("GetAirQualityHistory", float32(51.5), float32(-0.12),
+		mock.MatchedBy(start.Equal), mock.MatchedBy(end.Equal)).Return(&entity.AirQuality{}, nil)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet,
+		"/weather/air-quality/history?lat=51.5&lon=-0.12&start=2024-01-01T00:00:00Z&end=2024-01-02T00:00:00Z", nil)
+
+	// Act
+	handler.GetAirQualityHistory(c)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestWeatherHandler_GetHistoricalWeather_Success(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	mockService := new(MockWeatherService)
+	handler := NewWeatherHandler(mockService)
+
+	at := time.Date(2024, 1, 9, 14, 0, 0, 0, time.UTC)
+	mockService.On("GetHistoricalWeather", float32(38.4), float32(27.1), mock.MatchedBy(at.Equal), units.Metric, language.English).Return(&entity.HistoricalWeather{
+		Timezone:     "Europe/Istanbul",
+		Observations: []entity.HistoricalObservation{{Time: at, Temperature: 12.4, Description: "broken clouds"}},
+	}, nil)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/weather/history?lat=38.4&lon=27.1&at=2024-01-09T14:00:00Z", nil)
+
+	// Act
+	handler.GetHistoricalWeather(c)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response dto.HistoricalWeatherResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Len(t, response.Data.Observations, 1)
+	assert.Equal(t, "broken clouds", response.Data.Observations[0].Description)
+
+	mockService.AssertExpectations(t)
+}
+
+func TestWeatherHandler_GetHistoricalWeather_InvalidTimestamp(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	mockService := new(MockWeatherService)
+	handler := NewWeatherHandler(mockService)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/weather/history?lat=38.4&lon=27.1&at=last-tuesday", nil)
+
+	// Act
+	handler.GetHistoricalWeather(c)
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestWeatherHandler_GetHistoricalRange_Success(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	mockService := new(MockWeatherService)
+	handler := NewWeatherHandler(mockService)
+
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	mockService.On("GetHistoricalRange", float32(38.4), float32(27.1), from, to, units.Metric).Return(&entity.HistoricalRange{
+		From:     from,
+		To:       to,
+		TempMean: 7.5,
+		Days:     []entity.DaySummary{{Date: from}, {Date: to}},
+	}, nil)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/weather/history/range?lat=38.4&lon=27.1&from=2024-01-01&to=2024-01-02", nil)
+
+	// Act
+	handler.GetHistoricalRange(c)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response dto.HistoricalRangeResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, "2024-01-01", response.Data.From)
+	assert.Equal(t, "2024-01-02", response.Data.Days[1].Date)
+	assert.Equal(t, 7.5, response.Data.TempMean)
+
+	mockService.AssertExpectations(t)
+}
+
+func TestWeatherHandler_GetHistoricalRange_TooLong(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	mockService := new(MockWeatherService)
+	handler := NewWeatherHandler(mockService)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/weather/history/range?lat=38.4&lon=27.1&from=2024-01-01&to=2024-03-01", nil)
+
+	// Act
+	handler.GetHistoricalRange(c)
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockService.AssertNotCalled(t, "GetHistoricalRange", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestWeatherHandler_GetAlerts_Success(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	mockService := new(MockWeatherService)
+	handler := NewWeatherHandler(mockService)
+
+	activeAt := time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)
+	mockService.On("GetAlerts", float32(40.7), float32(-74), entity.AlertSeveritySevere, mock.MatchedBy(activeAt.Equal)).
+		Return([]entity.WeatherAlert{{Event: "Winter Storm Warning", Severity: entity.AlertSeveritySevere}}, nil)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet,
+		"/weather/alerts?lat=40.7&lon=-74&min_severity=Severe&active_at=2024-01-15T12:00:00Z", nil)
+
+	// Act
+	handler.GetAlerts(c)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response dto.AlertsResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Len(t, response.Data, 1)
+	assert.Equal(t, "severe", response.Data[0].Severity)
+
+	mockService.AssertExpectations(t)
+}
+
+func TestWeatherHandler_GetAlerts_EmptyList(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	mockService := new(MockWeatherService)
+	handler := NewWeatherHandler(mockService)
+
+	mockService.On("GetAlerts", float32(40.7), float32(-74), entity.AlertSeverityUnknown, time.Time{}).Return(nil, nil)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/weather/alerts?lat=40.7&lon=-74", nil)
+
+	// Act
+	handler.GetAlerts(c)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"success": true, "data": []}`, w.Body.String())
+}
+
+func TestWeatherHandler_GetAlerts_UnknownSeverity(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	mockService := new(MockWeatherService)
+	handler := NewWeatherHandler(mockService)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/weather/alerts?lat=40.7&lon=-74&min_severity=catastrophic", nil)
+
+	// Act
+	handler.GetAlerts(c)
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockService.AssertNotCalled(t, "GetAlerts", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestWeatherHandler_GetAlerts_NotImplemented(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	mockService := new(MockWeatherService)
+	handler := NewWeatherHandler(mockService)
+
+	mockService.On("GetAlerts", float32(40.7), float32(-74), entity.AlertSeverityUnknown, time.Time{}).
+		Return(nil, support.NewErrNotImplemented("alerts data is not available from the open-meteo provider"))
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/weather/alerts?lat=40.7&lon=-74", nil)
+
+	// Act
+	handler.GetAlerts(c)
+
+	// Assert
+	assert.Equal(t, http.StatusNotImplemented, w.Code)
+	assert.Contains(t, w.Body.String(), "open-meteo")
+}
+
 func TestWeatherHandler_HealthCheck(t *testing.T) {
 	// Arrange
 	gin.SetMode(gin.TestMode)
@@ -0,0 +1,81 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"weather-api/internal/core/domain/entity"
+	"weather-api/internal/core/service"
+	"weather-api/internal/dto"
+	"weather-api/internal/infrastructure/support"
+
+	"github.com/gin-gonic/gin"
+)
+
+const apiKeyContextKey = "api_key"
+
+// APIKeyAuthenticator resolves a presented secret to the key it belongs to.
+type APIKeyAuthenticator interface {
+	Authenticate(ctx context.Context, secret string) (*entity.APIKey, error)
+}
+
+// APIKeyAuth requires a valid API key granted scope. The key is read from the X-API-Key header or an
+// "Authorization: Bearer" header. Missing, unknown, expired and revoked keys get 401, keys without
+// scope get 403. The secret itself is never logged or stored on the context.
+func APIKeyAuth(authenticator APIKeyAuthenticator, scope entity.APIKeyScope) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		secret := apiKeySecret(c.Request)
+		if secret == "" {
+			abortWithError(c, http.StatusUnauthorized, support.NewErrUnauthorized("missing api key"))
+			return
+		}
+
+		key, err := authenticator.Authenticate(c.Request.Context(), secret)
+		switch {
+		case errors.Is(err, service.ErrInvalidAPIKey), errors.Is(err, service.ErrAPIKeyExpired), errors.Is(err, service.ErrAPIKeyRevoked):
+			abortWithError(c, http.StatusUnauthorized, support.NewErrUnauthorized(err.Error()))
+			return
+		case err != nil:
+			// Store failures are logged but not echoed; they may name files or hosts.
+			_ = c.Error(err)
+			c.AbortWithStatusJSON(http.StatusInternalServerError, dto.WeatherResponse{Success: false, Error: "authentication unavailable"})
+			return
+		}
+
+		c.Set(apiKeyContextKey, key)
+		if !key.HasScope(scope) {
+			abortWithError(c, http.StatusForbidden, support.NewErrForbidden("api key lacks scope "+string(scope)))
+			return
+		}
+		c.Next()
+	}
+}
+
+// GetAPIKey returns the authenticated API key if the request passed APIKeyAuth.
+func GetAPIKey(c *gin.Context) *entity.APIKey {
+	if v, ok := c.Get(apiKeyContextKey); ok {
+		if key, ok := v.(*entity.APIKey); ok {
+			return key
+		}
+	}
+	return nil
+}
+
+func apiKeySecret(r *http.Request) string {
+	if secret := strings.TrimSpace(r.Header.Get("X-API-Key")); secret != "" {
+		return secret
+	}
+	scheme, token, ok := strings.Cut(r.Header.Get("Authorization"), " ")
+	if ok && strings.EqualFold(scheme, "Bearer") {
+		return strings.TrimSpace(token)
+	}
+	return ""
+}
+
+// abortWithError stops the chain with the standard error envelope and records err for the logger.
+func abortWithError(c *gin.Context, status int, err error) {
+	_ = c.Error(err)
+	c.AbortWithStatusJSON(status, dto.WeatherResponse{Success: false, Error: err.Error()})
+}
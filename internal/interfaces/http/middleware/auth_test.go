@@ -0,0 +1,100 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"weather-api/internal/core/domain/entity"
+	"weather-api/internal/core/service"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+type stubAuthenticator struct {
+	keys map[string]*entity.APIKey
+	err  error
+}
+
+func (s *stubAuthenticator) Authenticate(ctx context.Context, secret string) (*entity.APIKey, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	if key, ok := s.keys[secret]; ok {
+		return key, nil
+	}
+	return nil, service.ErrInvalidAPIKey
+}
+
+func TestAPIKeyAuth(t *testing.T) {
+	authenticator := &stubAuthenticator{keys: map[string]*entity.APIKey{
+		"wapi_reader": {ID: "reader", Scopes: []entity.APIKeyScope{entity.ScopeWeatherRead}},
+		"wapi_admin":  {ID: "admin", Scopes: []entity.APIKeyScope{entity.ScopeAdmin}},
+	}}
+
+	tests := []struct {
+		name       string
+		header     string
+		value      string
+		wantStatus int
+		wantKeyID  string
+	}{
+		{"x-api-key header", "X-API-Key", "wapi_reader", http.StatusOK, "reader"},
+		{"bearer token", "Authorization", "Bearer wapi_reader", http.StatusOK, "reader"},
+		{"missing key", "", "", http.StatusUnauthorized, ""},
+		{"unknown key", "X-API-Key", "wapi_unknown", http.StatusUnauthorized, ""},
+		{"basic auth is ignored", "Authorization", "Basic d2FwaV9yZWFkZXI=", http.StatusUnauthorized, ""},
+		{"missing scope", "X-API-Key", "wapi_admin", http.StatusForbidden, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.Use(APIKeyAuth(authenticator, entity.ScopeWeatherRead))
+			router.GET("/weather", func(c *gin.Context) {
+				c.String(http.StatusOK, GetAPIKey(c).ID)
+			})
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, "/weather", nil)
+			if tt.header != "" {
+				req.Header.Set(tt.header, tt.value)
+			}
+
+			// Act
+			router.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, tt.wantStatus, w.Code)
+			if tt.wantStatus == http.StatusOK {
+				assert.Equal(t, tt.wantKeyID, w.Body.String())
+			} else {
+				assert.Contains(t, w.Body.String(), `"success":false`)
+			}
+		})
+	}
+}
+
+func TestAPIKeyAuth_StoreFailure(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(APIKeyAuth(&stubAuthenticator{err: errors.New("read /etc/keys.json: permission denied")}, entity.ScopeWeatherRead))
+	router.GET("/weather", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/weather", nil)
+	req.Header.Set("X-API-Key", "wapi_reader")
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	assert.NotContains(t, w.Body.String(), "/etc/keys.json")
+}
@@ -19,7 +19,7 @@ func CORS() gin.HandlerFunc {
 		AllowMethods: []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
 
 		// Allowed headers
-		AllowHeaders: []string{"Origin", "Content-Type", "Accept", "Authorization"},
+		AllowHeaders: []string{"Origin", "Content-Type", "Accept", "Authorization", "X-API-Key"},
 
 		// Expose headers (optional)
 		ExposeHeaders: []string{"Content-Length"},
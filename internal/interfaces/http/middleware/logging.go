@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 )
 
@@ -23,6 +24,14 @@ func Logger(logger *zap.Logger) gin.HandlerFunc {
 		statusCode := c.Writer.Status()
 		errorMessage := c.Errors.ByType(gin.ErrorTypePrivate).String()
 		requestID := GetRequestID(c)
+		var apiKeyID string
+		if key := GetAPIKey(c); key != nil {
+			apiKeyID = key.ID
+		}
+		var traceID string
+		if spanContext := trace.SpanContextFromContext(c.Request.Context()); spanContext.IsValid() {
+			traceID = spanContext.TraceID().String()
+		}
 
 		if raw != "" {
 			path = path + "?" + raw
@@ -30,6 +39,8 @@ func Logger(logger *zap.Logger) gin.HandlerFunc {
 
 		fields := []zap.Field{
 			zap.String("request_id", requestID),
+			zap.String("api_key_id", apiKeyID),
+			zap.String("trace_id", traceID),
 			zap.Int("status", statusCode),
 			zap.Duration("latency", latency),
 			zap.String("client_ip", clientIP),
@@ -0,0 +1,32 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMetrics_LabelsByRoutePattern(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(Metrics())
+	router.GET("/metrics-test/:city", func(c *gin.Context) { c.Status(http.StatusTeapot) })
+
+	found := httpRequestsTotal.WithLabelValues(http.MethodGet, "/metrics-test/:city", "418")
+	unmatched := httpRequestsTotal.WithLabelValues(http.MethodGet, "unmatched", "404")
+	foundBefore, unmatchedBefore := testutil.ToFloat64(found), testutil.ToFloat64(unmatched)
+
+	// Act
+	for _, path := range []string{"/metrics-test/London", "/metrics-test/Paris", "/no-such-route"} {
+		router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, path, nil))
+	}
+
+	// Assert
+	assert.Equal(t, 2.0, testutil.ToFloat64(found)-foundBefore)
+	assert.Equal(t, 1.0, testutil.ToFloat64(unmatched)-unmatchedBefore)
+}
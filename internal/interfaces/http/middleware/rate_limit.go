@@ -0,0 +1,92 @@
+package middleware
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"weather-api/internal/infrastructure/support"
+	"weather-api/pkg/ratelimit"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RateLimitPolicy holds the limits applied by RateLimit. Every request counts against Default, or
+// against Keys[id] for an API key with its own limit; requests to a route pattern listed in Routes
+// also count against that route's per-client limit.
+type RateLimitPolicy struct {
+	Default ratelimit.Limit
+	Routes  map[string]ratelimit.Limit
+	Keys    map[string]ratelimit.Limit
+}
+
+// RateLimit limits each client, identified by its API key when APIKeyAuth ran before it and by
+// c.ClientIP() otherwise. Every response carries RateLimit-Limit, RateLimit-Remaining, RateLimit-Reset
+// and RateLimit-Policy headers for the most restrictive bucket; rejected requests get 429 with
+// Retry-After.
+func RateLimit(limiter *ratelimit.Limiter, policy RateLimitPolicy) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		client := "ip:" + c.ClientIP()
+		limit := policy.Default
+		if key := GetAPIKey(c); key != nil {
+			client = "key:" + key.ID
+			if keyLimit, ok := policy.Keys[key.ID]; ok {
+				limit = keyLimit
+			}
+		}
+
+		checks := []ratelimit.Check{{Key: client, Limit: limit}}
+		if routeLimit, ok := policy.Routes[c.FullPath()]; ok {
+			checks = append(checks, ratelimit.Check{Key: client + "|" + c.FullPath(), Limit: routeLimit})
+		}
+
+		decision := limiter.Allow(checks...)
+		setRateLimitHeaders(c, decision)
+		if !decision.Allowed {
+			rejectRateLimited(c, decision)
+			return
+		}
+		c.Next()
+	}
+}
+
+// RateLimitFailedAuth limits failed authentications per IP and must run before APIKeyAuth. Once an IP
+// has used up limit on requests answered 401, its requests get 429 before their key is checked, so API
+// keys cannot be guessed faster than limit. Every request takes a token up front, so parallel guesses
+// cannot overrun the limit, and gets it back unless it was answered 401; requests that authenticate
+// therefore cost nothing here and RateLimit limits them per key.
+func RateLimitFailedAuth(limiter *ratelimit.Limiter, limit ratelimit.Limit) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		check := ratelimit.Check{Key: "auth-failure:ip:" + c.ClientIP(), Limit: limit}
+		if decision := limiter.Allow(check); !decision.Allowed {
+			setRateLimitHeaders(c, decision)
+			rejectRateLimited(c, decision)
+			return
+		}
+		c.Next()
+		if c.Writer.Status() != http.StatusUnauthorized {
+			limiter.Refund(check)
+		}
+	}
+}
+
+// setRateLimitHeaders describes the most restrictive bucket of decision.
+func setRateLimitHeaders(c *gin.Context, decision ratelimit.Decision) {
+	header := c.Writer.Header()
+	header.Set("RateLimit-Limit", strconv.Itoa(decision.Limit.Requests))
+	header.Set("RateLimit-Remaining", strconv.Itoa(decision.Remaining))
+	header.Set("RateLimit-Reset", strconv.Itoa(ceilSeconds(decision.Reset)))
+	header.Set("RateLimit-Policy", strconv.Itoa(decision.Limit.Requests)+";w="+strconv.Itoa(ceilSeconds(decision.Limit.Period)))
+}
+
+// rejectRateLimited answers 429 with Retry-After for a denied decision.
+func rejectRateLimited(c *gin.Context, decision ratelimit.Decision) {
+	c.Writer.Header().Set("Retry-After", strconv.Itoa(ceilSeconds(decision.RetryAfter)))
+	abortWithError(c, http.StatusTooManyRequests, support.NewErrTooManyRequests("rate limit exceeded, retry in "+strconv.Itoa(ceilSeconds(decision.RetryAfter))+"s"))
+}
+
+// ceilSeconds rounds d up to whole seconds, as the rate limit headers require.
+func ceilSeconds(d time.Duration) int {
+	return int(math.Ceil(d.Seconds()))
+}
@@ -0,0 +1,171 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"weather-api/internal/core/domain/entity"
+	"weather-api/pkg/ratelimit"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func newRateLimitedRouter(policy RateLimitPolicy, key *entity.APIKey) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	if key != nil {
+		router.Use(func(c *gin.Context) { c.Set(apiKeyContextKey, key) })
+	}
+	router.Use(RateLimit(ratelimit.NewLimiter(100), policy))
+	router.GET("/weather/:city", func(c *gin.Context) { c.Status(http.StatusOK) })
+	router.POST("/weather/batch", func(c *gin.Context) { c.Status(http.StatusOK) })
+	return router
+}
+
+func serve(router *gin.Engine, method string, path string, remoteAddr string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(method, path, nil)
+	req.RemoteAddr = remoteAddr
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestRateLimit_PerIP(t *testing.T) {
+	// Arrange
+	router := newRateLimitedRouter(RateLimitPolicy{Default: ratelimit.Limit{Requests: 2, Period: time.Minute}}, nil)
+
+	// Act
+	first := serve(router, http.MethodGet, "/weather/London", "10.0.0.1:1234")
+	second := serve(router, http.MethodGet, "/weather/Paris", "10.0.0.1:1234")
+	limited := serve(router, http.MethodGet, "/weather/Rome", "10.0.0.1:1234")
+	otherClient := serve(router, http.MethodGet, "/weather/Rome", "10.0.0.2:1234")
+
+	// Assert
+	assert.Equal(t, http.StatusOK, first.Code)
+	assert.Equal(t, "2", first.Header().Get("RateLimit-Limit"))
+	assert.Equal(t, "1", first.Header().Get("RateLimit-Remaining"))
+	assert.Equal(t, "2;w=60", first.Header().Get("RateLimit-Policy"))
+	assert.Equal(t, http.StatusOK, second.Code)
+
+	assert.Equal(t, http.StatusTooManyRequests, limited.Code)
+	assert.Equal(t, "0", limited.Header().Get("RateLimit-Remaining"))
+	assert.Equal(t, "30", limited.Header().Get("Retry-After"))
+	assert.Equal(t, "60", limited.Header().Get("RateLimit-Reset"))
+	assert.JSONEq(t, `{"success": false, "error": "rate limit exceeded, retry in 30s"}`, limited.Body.String())
+
+	assert.Equal(t, http.StatusOK, otherClient.Code)
+}
+
+func TestRateLimit_PerKeyAndRoute(t *testing.T) {
+	// Arrange
+	policy := RateLimitPolicy{
+		Default: ratelimit.Limit{Requests: 1, Period: time.Minute},
+		Keys:    map[string]ratelimit.Limit{"nightly": {Requests: 100, Period: time.Minute}},
+		Routes:  map[string]ratelimit.Limit{"/weather/batch": {Requests: 1, Period: time.Hour}},
+	}
+	router := newRateLimitedRouter(policy, &entity.APIKey{ID: "nightly"})
+
+	// Act
+	lookup := serve(router, http.MethodGet, "/weather/London", "10.0.0.1:1234")
+	anotherLookup := serve(router, http.MethodGet, "/weather/London", "10.0.0.1:1234")
+	batch := serve(router, http.MethodPost, "/weather/batch", "10.0.0.1:1234")
+	secondBatch := serve(router, http.MethodPost, "/weather/batch", "10.0.0.1:1234")
+
+	// Assert
+	assert.Equal(t, http.StatusOK, lookup.Code)
+	assert.Equal(t, http.StatusOK, anotherLookup.Code, "the key's own limit replaces the default")
+	assert.Equal(t, "100", anotherLookup.Header().Get("RateLimit-Limit"))
+
+	assert.Equal(t, http.StatusOK, batch.Code)
+	assert.Equal(t, "1", batch.Header().Get("RateLimit-Limit"), "the route bucket is the most restrictive")
+	assert.Equal(t, http.StatusTooManyRequests, secondBatch.Code)
+	assert.Equal(t, "3600", secondBatch.Header().Get("Retry-After"))
+}
+
+func TestRateLimitFailedAuth(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	authenticator := &stubAuthenticator{keys: map[string]*entity.APIKey{
+		"wapi_reader": {ID: "reader", Scopes: []entity.APIKeyScope{entity.ScopeWeatherRead}},
+	}}
+	router := gin.New()
+	router.Use(RateLimitFailedAuth(ratelimit.NewLimiter(100), ratelimit.Limit{Requests: 2, Period: time.Minute}))
+	router.Use(APIKeyAuth(authenticator, entity.ScopeWeatherRead))
+	router.GET("/weather/:city", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	guess := func(secret string, remoteAddr string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/weather/London", nil)
+		req.Header.Set("X-API-Key", secret)
+		req.RemoteAddr = remoteAddr
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	// Act
+	valid := guess("wapi_reader", "10.0.0.1:1234")
+	first := guess("wapi_guess1", "10.0.0.1:1234")
+	second := guess("wapi_guess2", "10.0.0.1:1234")
+	throttled := guess("wapi_guess3", "10.0.0.1:1234")
+	throttledValid := guess("wapi_reader", "10.0.0.1:1234")
+	otherClient := guess("wapi_guess4", "10.0.0.2:1234")
+
+	// Assert
+	assert.Equal(t, http.StatusOK, valid.Code)
+	assert.Empty(t, valid.Header().Get("RateLimit-Limit"), "authenticated requests are limited per key, not here")
+	assert.Equal(t, http.StatusUnauthorized, first.Code)
+	assert.Equal(t, http.StatusUnauthorized, second.Code)
+	assert.Equal(t, http.StatusTooManyRequests, throttled.Code)
+	assert.Equal(t, "30", throttled.Header().Get("Retry-After"))
+	assert.Equal(t, http.StatusTooManyRequests, throttledValid.Code, "the key is not checked while the IP is throttled")
+	assert.Equal(t, http.StatusUnauthorized, otherClient.Code)
+}
+
+func TestRateLimitFailedAuth_ParallelGuesses(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	const guesses = 10
+	var entered sync.WaitGroup
+	entered.Add(2)
+	release := make(chan struct{})
+	router := gin.New()
+	router.Use(RateLimitFailedAuth(ratelimit.NewLimiter(100), ratelimit.Limit{Requests: 2, Period: time.Minute}))
+	router.GET("/weather/:city", func(c *gin.Context) {
+		// Hold the admitted guesses until every other one has been decided.
+		entered.Done()
+		<-release
+		c.Status(http.StatusUnauthorized)
+	})
+
+	codes := make(chan int, guesses)
+	var done sync.WaitGroup
+
+	// Act
+	for i := 0; i < guesses; i++ {
+		done.Add(1)
+		go func() {
+			defer done.Done()
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, "/weather/London", nil)
+			req.RemoteAddr = "10.0.0.1:1234"
+			router.ServeHTTP(w, req)
+			codes <- w.Code
+		}()
+	}
+	for i := 0; i < guesses-2; i++ {
+		assert.Equal(t, http.StatusTooManyRequests, <-codes)
+	}
+	entered.Wait()
+	close(release)
+	done.Wait()
+	close(codes)
+
+	// Assert
+	for code := range codes {
+		assert.Equal(t, http.StatusUnauthorized, code)
+	}
+}
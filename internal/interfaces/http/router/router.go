@@ -1,26 +1,42 @@
 package router
 
 import (
+	"weather-api/internal/core/domain/entity"
 	"weather-api/internal/interfaces/http/handler"
 	"weather-api/internal/interfaces/http/middleware"
 
 	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	swaggerFiles "github.com/swaggo/files"
 	ginSwagger "github.com/swaggo/gin-swagger"
 	"go.uber.org/zap"
 )
 
-// SetupRouter configures and returns the HTTP router
-func SetupRouter(weatherHandler *handler.WeatherHandler, logger *zap.Logger, swaggerBasePath string) *gin.Engine {
+// SetupRouter configures and returns the HTTP router. When authenticator is nil, API key authentication
+// is disabled and the admin routes are not mounted, except for the read-only stats routes when
+// publicAdminStats is set; when rateLimit is nil, requests are not rate limited, and when authFailureLimit
+// is nil, neither are failed authentications; when tracing is nil, requests are not traced; when
+// metricsPath is empty, request metrics are neither recorded nor exposed.
+func SetupRouter(weatherHandler *handler.WeatherHandler, geoHandler *handler.GeoHandler, batchHandler *handler.BatchHandler, adminHandler *handler.AdminHandler, healthHandler *handler.HealthHandler, apiKeyHandler *handler.APIKeyHandler, authenticator middleware.APIKeyAuthenticator, publicAdminStats bool, rateLimit gin.HandlerFunc, authFailureLimit gin.HandlerFunc, tracing gin.HandlerFunc, logger *zap.Logger, swaggerBasePath string, metricsPath string) *gin.Engine {
 	// Create a new router without any default middleware
 	router := gin.New()
 
 	// Apply CORS middleware to all incoming requests. This should be one of the first middleware.
 	router.Use(middleware.CORS())
 
+	// The request span must enclose every later middleware and the handler
+	if tracing != nil {
+		router.Use(tracing)
+	}
+
 	// Request ID must run early to populate context and response header
 	router.Use(middleware.RequestID())
 
+	// Metrics wrap Recovery so that requests ending in a panic are counted as 500s
+	if metricsPath != "" {
+		router.Use(middleware.Metrics())
+	}
+
 	// Add other essential middleware
 	// Recovery middleware recovers from any panics and writes a 500 if there was one.
 	router.Use(gin.Recovery())
@@ -28,14 +44,70 @@ func SetupRouter(weatherHandler *handler.WeatherHandler, logger *zap.Logger, swa
 	// Use structured logger middleware for all requests
 	router.Use(middleware.Logger(logger))
 
-	// Health check endpoint
+	// Health check endpoints: liveness never looks at dependencies, readiness runs the dependency checks
 	router.GET("/health", weatherHandler.HealthCheck)
+	router.GET("/livez", healthHandler.Liveness)
+	router.GET("/readyz", healthHandler.Readiness)
+
+	// Prometheus scrape endpoint; like the health checks it is not behind authentication
+	if metricsPath != "" {
+		router.GET(metricsPath, gin.WrapH(promhttp.Handler()))
+	}
+
+	// Every route below requires an API key with the group's scope when authentication is enabled,
+	// and is rate limited per key (or per IP without authentication) after that. Failed authentications
+	// are limited per IP before the key is checked, so keys cannot be guessed at an unlimited rate.
+	requireScope := func(scope entity.APIKeyScope) []gin.HandlerFunc {
+		var chain []gin.HandlerFunc
+		if authenticator != nil {
+			if authFailureLimit != nil {
+				chain = append(chain, authFailureLimit)
+			}
+			chain = append(chain, middleware.APIKeyAuth(authenticator, scope))
+		}
+		if rateLimit != nil {
+			chain = append(chain, rateLimit)
+		}
+		return chain
+	}
 
 	// Weather endpoints
-	weatherGroup := router.Group("/weather")
+	weatherGroup := router.Group("/weather", requireScope(entity.ScopeWeatherRead)...)
 	{
 		weatherGroup.GET("/:city", weatherHandler.GetWeatherByCity)
 		weatherGroup.GET("/overview", weatherHandler.GetWeatherOverviewByLatLong)
+		weatherGroup.GET("/onecall", weatherHandler.GetOneCall)
+		weatherGroup.GET("/air-quality", weatherHandler.GetAirQuality)
+		weatherGroup.GET("/air-quality/forecast", weatherHandler.GetAirQualityForecast)
+		weatherGroup.GET("/air-quality/history", weatherHandler.GetAirQualityHistory)
+		weatherGroup.GET("/history", weatherHandler.GetHistoricalWeather)
+		weatherGroup.GET("/history/range", weatherHandler.GetHistoricalRange)
+		weatherGroup.GET("/alerts", weatherHandler.GetAlerts)
+		weatherGroup.GET("/:city/forecast", weatherHandler.GetForecastByCity)
+		weatherGroup.POST("/batch", batchHandler.GetWeatherBatch)
+	}
+
+	// Geocoding endpoints
+	geoGroup := router.Group("/geo", requireScope(entity.ScopeWeatherRead)...)
+	{
+		geoGroup.GET("/search", geoHandler.SearchLocations)
+		geoGroup.GET("/reverse", geoHandler.ReverseGeocode)
+	}
+
+	// Admin endpoints expose quotas and provider internals, so they only exist behind authentication,
+	// unless the operator opted in to serving the read-only stats to everyone
+	if authenticator != nil || publicAdminStats {
+		adminGroup := router.Group("/admin", requireScope(entity.ScopeAdmin)...)
+		{
+			adminGroup.GET("/shadow", adminHandler.GetShadowStats)
+			adminGroup.GET("/quota", adminHandler.GetQuotaUsage)
+			adminGroup.GET("/breakers", adminHandler.GetCircuitBreakers)
+			if authenticator != nil {
+				adminGroup.POST("/keys", apiKeyHandler.CreateAPIKey)
+				adminGroup.GET("/keys", apiKeyHandler.ListAPIKeys)
+				adminGroup.DELETE("/keys/:id", apiKeyHandler.RevokeAPIKey)
+			}
+		}
 	}
 
 	// Swagger endpoint
@@ -0,0 +1,69 @@
+package router
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"weather-api/internal/core/domain/entity"
+	"weather-api/internal/core/service"
+	"weather-api/internal/interfaces/http/handler"
+	"weather-api/internal/interfaces/http/middleware"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+type stubAuthenticator struct{}
+
+func (stubAuthenticator) Authenticate(ctx context.Context, secret string) (*entity.APIKey, error) {
+	return nil, service.ErrInvalidAPIKey
+}
+
+type stubBreakers struct{}
+
+func (stubBreakers) Statuses() []entity.CircuitBreakerStatus { return nil }
+
+func newTestRouter(authenticator middleware.APIKeyAuthenticator, publicAdminStats bool) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	var apiKeyHandler *handler.APIKeyHandler
+	if authenticator != nil {
+		apiKeyHandler = handler.NewAPIKeyHandler(nil)
+	}
+	return SetupRouter(nil, nil, nil, handler.NewAdminHandler(nil, nil, stubBreakers{}), nil, apiKeyHandler, authenticator, publicAdminStats, nil, nil, nil, zap.NewNop(), "", "")
+}
+
+func TestSetupRouter_AdminRoutes(t *testing.T) {
+	paths := []string{"/admin/shadow", "/admin/quota", "/admin/breakers", "/admin/keys"}
+
+	tests := []struct {
+		name             string
+		authenticator    middleware.APIKeyAuthenticator
+		publicAdminStats bool
+		wantStatus       []int
+	}{
+		{"not mounted without authentication", nil, false, []int{http.StatusNotFound, http.StatusNotFound, http.StatusNotFound, http.StatusNotFound}},
+		{"only stats are public when opted in", nil, true, []int{http.StatusOK, http.StatusOK, http.StatusOK, http.StatusNotFound}},
+		{"require a key with authentication", stubAuthenticator{}, false, []int{http.StatusUnauthorized, http.StatusUnauthorized, http.StatusUnauthorized, http.StatusUnauthorized}},
+		{"require a key with authentication even when public", stubAuthenticator{}, true, []int{http.StatusUnauthorized, http.StatusUnauthorized, http.StatusUnauthorized, http.StatusUnauthorized}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			router := newTestRouter(tt.authenticator, tt.publicAdminStats)
+
+			for i, path := range paths {
+				w := httptest.NewRecorder()
+
+				// Act
+				router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+
+				// Assert
+				assert.Equal(t, tt.wantStatus[i], w.Code, path)
+			}
+		})
+	}
+}
@@ -1,23 +1,33 @@
 package server
 
 import (
+	"context"
 	"log"
 	"net/http"
 
+	"weather-api/internal/core/domain/repository"
 	"weather-api/internal/core/service"
+	"weather-api/internal/infrastructure/adapter/keystore"
 	"weather-api/internal/infrastructure/adapter/weather"
 	"weather-api/internal/infrastructure/config"
 	"weather-api/internal/infrastructure/support"
+	"weather-api/internal/infrastructure/tracing"
 	"weather-api/internal/interfaces/http/handler"
+	"weather-api/internal/interfaces/http/middleware"
 	"weather-api/internal/interfaces/http/router"
+	"weather-api/pkg/ratelimit"
 
 	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/gin-gonic/gin/otelgin"
 )
 
-// Container holds all the dependencies for the application.
+// Container holds all the dependencies for the application. Shutdown flushes pending trace spans
+// and must be called once the server has stopped.
 type Container struct {
-	Router http.Handler
-	Config *config.Config
+	Router   http.Handler
+	Config   *config.Config
+	Shutdown func(ctx context.Context) error
 }
 
 // BuildContainer creates and wires all the application dependencies.
@@ -25,36 +35,180 @@ func BuildContainer() *Container {
 	// Load configuration
 	cfg := config.LoadConfig()
 
-	// Validate required configuration
-	if cfg.Weather.APIKey == "" {
-		log.Fatal("OPENWEATHER_API_KEY environment variable is required")
+	// Install the tracer provider first so that every component below picks it up
+	shutdownTracing, err := tracing.Setup(context.Background(), cfg.Tracing)
+	if err != nil {
+		log.Fatalf("tracing: %v", err)
+	}
+
+	// Initialize structured logger, used for requests and circuit breaker state changes
+	logger, err := support.NewLogger(cfg.Server.GinMode)
+	if err != nil {
+		log.Fatalf("failed to initialize logger: %v", err)
+	}
+
+	// OpenWeather calls count against one quota however many adapters make them
+	var quota *weather.QuotaManager
+	if cfg.Quota.Enabled {
+		quota = weather.NewQuotaManager(cfg.Quota)
+	}
+
+	// Initialize the configured weather provider
+	provider, err := weather.NewProvider(cfg.Weather.Provider, cfg, quota, logger)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Collect the primary and fallback providers in priority order
+	members := []weather.FailoverMember{{Name: cfg.Weather.Provider, Repository: provider}}
+	providers := []weather.Provider{provider}
+	for _, name := range cfg.Weather.FallbackProviders {
+		if name == cfg.Weather.Provider {
+			continue
+		}
+		fallback, err := weather.NewProvider(name, cfg, quota, logger)
+		if err != nil {
+			log.Fatalf("fallback provider: %v", err)
+		}
+		members = append(members, weather.FailoverMember{Name: name, Repository: fallback})
+		providers = append(providers, fallback)
+	}
+
+	// Blend current readings from every provider in consensus mode, otherwise fail over in order;
+	// either way the per-provider failover counters are exported
+	var upstream repository.WeatherRepository = provider
+	var failover *weather.FailoverWeatherRepository
+	switch {
+	case cfg.Consensus.Enabled && len(members) > 1:
+		consensus, err := weather.NewConsensusWeatherRepository(cfg.Consensus, members...)
+		if err != nil {
+			log.Fatal(err)
+		}
+		upstream = consensus
+		failover = consensus.FailoverWeatherRepository
+	case len(members) > 1:
+		failover = weather.NewFailoverWeatherRepository(members...)
+		upstream = failover
+	}
+	if failover != nil && cfg.Metrics.Enabled {
+		prometheus.MustRegister(failover)
+	}
+
+	// Collapse concurrent identical upstream requests, then wrap with the response cache when enabled
+	coalescingRepo := weather.NewCoalescingWeatherRepository(upstream)
+	if cfg.Metrics.Enabled {
+		prometheus.MustRegister(coalescingRepo)
+	}
+	var weatherRepo repository.WeatherRepository = coalescingRepo
+	if cfg.Cache.Enabled {
+		cachedRepo := weather.NewCachedWeatherRepository(weatherRepo, cfg.Cache)
+		if cfg.Metrics.Enabled {
+			prometheus.MustRegister(cachedRepo)
+		}
+		weatherRepo = cachedRepo
 	}
 
-	// Initialize adapters
-	weatherAdapter := weather.NewOpenWeatherAdapterWithConfig(cfg.Weather)
+	// Replay a sample of calls against the shadow candidate without affecting responses
+	var shadowStats handler.ShadowStatsSource
+	if cfg.Shadow.Enabled {
+		candidate, err := weather.NewProvider(cfg.Shadow.Provider, cfg, quota, logger)
+		if err != nil {
+			log.Fatalf("shadow provider: %v", err)
+		}
+		providers = append(providers, candidate)
+		shadowRepo := weather.NewShadowWeatherRepository(weatherRepo, candidate, cfg.Shadow)
+		weatherRepo = shadowRepo
+		shadowStats = shadowRepo
+	}
 
 	// Initialize services
-	weatherService := service.NewWeatherService(weatherAdapter)
+	var weatherService service.WeatherServiceInterface = service.NewWeatherService(weatherRepo)
+	if cfg.Tracing.Enabled {
+		weatherService = tracing.NewWeatherService(weatherService)
+	}
+	geocodingService := service.NewGeocodingService(provider)
+	batchService := service.NewBatchService(weatherService, cfg.Batch.MaxItems, cfg.Batch.StreamMaxItems, cfg.Batch.Concurrency)
+
+	// Keys are only loaded, and key management only mounted, when authentication is enabled
+	var apiKeyService *service.APIKeyService
+	var keyStore *keystore.FileStore
+	if cfg.Auth.Enabled {
+		keyStore, err = keystore.NewFileStore(cfg.Auth.KeyFile)
+		if err != nil {
+			log.Fatalf("api key store: %v", err)
+		}
+		apiKeyService = service.NewAPIKeyService(keyStore)
+	}
+
+	// Readiness probes every provider serving requests and their breakers, and the key store when one is loaded
+	healthChecks := weather.HealthChecks(cfg.Health.ProbeTTL, members...)
+	if keyStore != nil {
+		healthChecks = append(healthChecks, service.HealthCheck{Name: "api_key_store", Check: keyStore.Ping})
+	}
+	healthService := service.NewHealthService(cfg.Health.CheckTimeout, healthChecks...)
 
 	// Initialize handlers
 	weatherHandler := handler.NewWeatherHandler(weatherService)
+	geoHandler := handler.NewGeoHandler(geocodingService)
+	batchHandler := handler.NewBatchHandler(batchService, cfg.Batch.StreamWriteTimeout)
+	var quotaUsage handler.QuotaUsageSource
+	if quota != nil {
+		quotaUsage = quota
+	}
+	adminHandler := handler.NewAdminHandler(shadowStats, quotaUsage, weather.NewCircuitBreakerStatuses(providers...))
+	healthHandler := handler.NewHealthHandler(healthService)
+	var apiKeyHandler *handler.APIKeyHandler
+	var authenticator middleware.APIKeyAuthenticator
+	if apiKeyService != nil {
+		apiKeyHandler = handler.NewAPIKeyHandler(apiKeyService)
+		authenticator = apiKeyService
+	}
+
+	// One limiter is shared by every route group so a client's default limit spans all of them; failed
+	// authentications count against the default limit per IP, in buckets of their own
+	var rateLimit, authFailureLimit gin.HandlerFunc
+	if cfg.RateLimit.Enabled {
+		limiter := ratelimit.NewLimiter(cfg.RateLimit.MaxClients)
+		rateLimit = middleware.RateLimit(limiter, middleware.RateLimitPolicy{
+			Default: cfg.RateLimit.Default,
+			Routes:  cfg.RateLimit.Routes,
+			Keys:    cfg.RateLimit.Keys,
+		})
+		authFailureLimit = middleware.RateLimitFailedAuth(limiter, cfg.RateLimit.Default)
+	}
+
+	// Trace every request except health checks and metric scrapes, continuing the caller's W3C trace context
+	var requestTracing gin.HandlerFunc
+	if cfg.Tracing.Enabled {
+		untraced := map[string]bool{"/health": true, "/livez": true, "/readyz": true, cfg.Metrics.Path: true}
+		requestTracing = otelgin.Middleware(cfg.Tracing.ServiceName, otelgin.WithFilter(func(req *http.Request) bool {
+			return !untraced[req.URL.Path]
+		}))
+	}
 
 	// Configure Gin mode before creating the router (debug|release|test)
 	if cfg.Server.GinMode != "" {
 		gin.SetMode(cfg.Server.GinMode)
 	}
 
-	// Initialize structured logger
-	logger, err := support.NewLogger(cfg.Server.GinMode)
-	if err != nil {
-		log.Fatalf("failed to initialize logger: %v", err)
+	// Setup router with logger, swagger base path and metrics path
+	var metricsPath string
+	if cfg.Metrics.Enabled {
+		metricsPath = cfg.Metrics.Path
+	}
+	// Without authentication the shadow and quota views are unreachable unless explicitly made public
+	if !cfg.Auth.Enabled && !cfg.Admin.PublicStats && (cfg.Shadow.Enabled || cfg.Quota.Enabled) {
+		logger.Warn("admin routes are not mounted without authentication; set AUTH_ENABLED or ADMIN_PUBLIC_STATS to view shadow and quota stats")
 	}
 
-	// Setup router with logger and swagger base path
-	r := router.SetupRouter(weatherHandler, logger, cfg.Swagger.BasePath)
+	r := router.SetupRouter(weatherHandler, geoHandler, batchHandler, adminHandler, healthHandler, apiKeyHandler, authenticator, cfg.Admin.PublicStats, rateLimit, authFailureLimit, requestTracing, logger, cfg.Swagger.BasePath, metricsPath)
+	if err := r.SetTrustedProxies(cfg.Server.TrustedProxies); err != nil {
+		log.Fatalf("invalid TRUSTED_PROXIES: %v", err)
+	}
 
 	return &Container{
-		Router: r,
-		Config: cfg,
+		Router:   r,
+		Config:   cfg,
+		Shutdown: shutdownTracing,
 	}
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
@@ -25,12 +26,18 @@ func Run() {
 	log.Printf("Health check: http://localhost%s/health", serverAddr)
 	log.Printf("Weather API: http://localhost%s/weather", serverAddr)
 
+	// Every request context derives from baseCtx so in-flight upstream work can be
+	// canceled when the graceful shutdown period runs out
+	baseCtx, cancelRequests := context.WithCancel(context.Background())
+	defer cancelRequests()
+
 	srv := &http.Server{
 		Addr:         serverAddr,
 		Handler:      router,
 		ReadTimeout:  cfg.Server.ReadTimeout,
 		WriteTimeout: cfg.Server.WriteTimeout,
 		IdleTimeout:  cfg.Server.IdleTimeout,
+		BaseContext:  func(net.Listener) context.Context { return baseCtx },
 	}
 
 	// Start server in a separate goroutine
@@ -52,5 +59,11 @@ func Run() {
 
 	if err := srv.Shutdown(shutdownCtx); err != nil {
 		log.Printf("Server shutdown error: %v", err)
+		// Abort requests that are still waiting on upstream calls
+		cancelRequests()
+	}
+
+	if err := container.Shutdown(shutdownCtx); err != nil {
+		log.Printf("Tracing shutdown error: %v", err)
 	}
 }
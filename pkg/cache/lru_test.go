@@ -0,0 +1,70 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLRU_GetSet(t *testing.T) {
+	c := NewLRU[string, int](2)
+
+	c.Set("a", 1, time.Minute)
+
+	value, ok := c.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, 1, value)
+
+	_, ok = c.Get("missing")
+	assert.False(t, ok)
+}
+
+func TestLRU_EvictsLeastRecentlyUsed(t *testing.T) {
+	c := NewLRU[string, int](2)
+
+	c.Set("a", 1, time.Minute)
+	c.Set("b", 2, time.Minute)
+	_, _ = c.Get("a") // a becomes most recently used
+	c.Set("c", 3, time.Minute)
+
+	_, ok := c.Get("b")
+	assert.False(t, ok, "b should have been evicted")
+	_, ok = c.Get("a")
+	assert.True(t, ok)
+	_, ok = c.Get("c")
+	assert.True(t, ok)
+	assert.Equal(t, 2, c.Len())
+}
+
+func TestLRU_ExpiresEntries(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	c := NewLRU[string, int](10)
+	c.now = func() time.Time { return now }
+
+	c.Set("a", 1, time.Minute)
+
+	now = now.Add(59 * time.Second)
+	_, ok := c.Get("a")
+	assert.True(t, ok)
+
+	now = now.Add(time.Second)
+	_, ok = c.Get("a")
+	assert.False(t, ok)
+	assert.Equal(t, 0, c.Len(), "expired entry should be removed on access")
+}
+
+func TestLRU_SetReplacesExisting(t *testing.T) {
+	c := NewLRU[string, int](2)
+
+	c.Set("a", 1, time.Minute)
+	c.Set("a", 2, time.Minute)
+
+	value, ok := c.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, 2, value)
+	assert.Equal(t, 1, c.Len())
+
+	c.Delete("a")
+	assert.Equal(t, 0, c.Len())
+}
@@ -2,42 +2,244 @@ package circuitbreaker
 
 import (
 	"context"
-	"github.com/sony/gobreaker"
-	"log"
+	"errors"
+	"sync/atomic"
 	"time"
+
+	"github.com/sony/gobreaker"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.uber.org/zap"
+)
+
+// Errors returned by Execute when the breaker rejects a call without running it.
+var (
+	ErrOpenState       = gobreaker.ErrOpenState
+	ErrTooManyRequests = gobreaker.ErrTooManyRequests
 )
 
+// State is a breaker state: closed, half-open or open.
+type State = gobreaker.State
+
+// States reported by State.
+const (
+	StateClosed   = gobreaker.StateClosed
+	StateHalfOpen = gobreaker.StateHalfOpen
+	StateOpen     = gobreaker.StateOpen
+)
+
+// ErrNotAttempted is matched, via errors.Is, by errors of calls that were refused locally and never
+// reached the protected dependency, such as an exhausted call quota. They do not count as failures.
+var ErrNotAttempted = errors.New("call not attempted")
+
+// ErrCallerError is matched, via errors.Is, by errors the dependency returned because the request
+// itself was at fault, such as an unknown city. The dependency answered correctly, so they do not
+// count as failures either.
+var ErrCallerError = errors.New("caller error")
+
 type CircuitBreaker struct {
-	cb *gobreaker.CircuitBreaker
+	cb       *gobreaker.CircuitBreaker
+	rejected atomic.Uint64
+}
+
+// Settings tunes when a breaker trips and recovers. Within each Interval of the closed state, the
+// breaker opens once at least MinRequests calls were made and FailureRatio of them failed. After
+// Timeout open it turns half-open and lets MaxRequests trial calls through. IsSuccessful decides
+// which errors count as failures; nil uses DefaultIsSuccessful. OnStateChange, when set, is called
+// on every state change after it was logged and recorded in the metrics.
+type Settings struct {
+	MaxRequests   uint32
+	Interval      time.Duration
+	Timeout       time.Duration
+	MinRequests   uint32
+	FailureRatio  float64
+	IsSuccessful  func(err error) bool
+	OnStateChange func(name string, from State, to State)
+}
+
+// Snapshot is a point-in-time view of a breaker. The request, success and failure counts cover the
+// current generation, which starts over on every state change and, while closed, every Interval.
+// Rejected counts the calls the breaker refused since it was created.
+type Snapshot struct {
+	Name                 string
+	State                State
+	Requests             uint32
+	TotalSuccesses       uint32
+	TotalFailures        uint32
+	ConsecutiveSuccesses uint32
+	ConsecutiveFailures  uint32
+	Rejected             uint64
+}
+
+// DefaultSettings returns the settings used by NewCircuitBreaker.
+func DefaultSettings() Settings {
+	return Settings{
+		MaxRequests:  3,
+		Interval:     10 * time.Second,
+		Timeout:      60 * time.Second,
+		MinRequests:  3,
+		FailureRatio: 0.6,
+	}
+}
+
+// DefaultIsSuccessful counts every error as a failure except a caller giving up, a call refused before
+// it was sent and a request the dependency rightly rejected, none of which says the dependency is unhealthy.
+func DefaultIsSuccessful(err error) bool {
+	return err == nil || errors.Is(err, context.Canceled) || errors.Is(err, ErrNotAttempted) || errors.Is(err, ErrCallerError)
 }
 
-// NewCircuitBreaker creates a new circuit breaker instance
+// NewCircuitBreaker creates a new circuit breaker instance with DefaultSettings that logs its state
+// changes to stderr.
 func NewCircuitBreaker(name string) *CircuitBreaker {
+	return NewCircuitBreakerWithSettings(name, DefaultSettings(), nil)
+}
+
+// NewCircuitBreakerWithSettings creates a new circuit breaker instance tuned by settings. State changes
+// are logged to logger at warn level when the breaker opens and info level otherwise; a nil logger
+// logs to stderr.
+func NewCircuitBreakerWithSettings(name string, settings Settings, logger *zap.Logger) *CircuitBreaker {
+	if logger == nil {
+		logger = defaultLogger()
+	}
+	isSuccessful := settings.IsSuccessful
+	if isSuccessful == nil {
+		isSuccessful = DefaultIsSuccessful
+	}
+
 	cb := gobreaker.NewCircuitBreaker(gobreaker.Settings{
 		Name:        name,
-		MaxRequests: 3,
-		Interval:    10 * time.Second,
-		Timeout:     60 * time.Second,
+		MaxRequests: settings.MaxRequests,
+		Interval:    settings.Interval,
+		Timeout:     settings.Timeout,
 
 		ReadyToTrip: func(counts gobreaker.Counts) bool {
 			failureRatio := float64(counts.TotalFailures) / float64(counts.Requests)
-			return counts.Requests >= 3 && failureRatio >= 0.6
+			return counts.Requests >= settings.MinRequests && failureRatio >= settings.FailureRatio
 		},
 
+		IsSuccessful: isSuccessful,
+
 		OnStateChange: func(name string, from gobreaker.State, to gobreaker.State) {
-			log.Printf("Circuit breaker state changed: %s -> %s", from, to)
+			level := zap.InfoLevel
+			if to == StateOpen {
+				level = zap.WarnLevel
+			}
+			logger.Log(level, "circuit breaker state changed",
+				zap.String("circuit_breaker", name),
+				zap.Stringer("from", from),
+				zap.Stringer("to", to),
+			)
+			recordState(name, from, to)
+			if settings.OnStateChange != nil {
+				settings.OnStateChange(name, from, to)
+			}
 		},
 	})
+	recordState(name, gobreaker.StateClosed, gobreaker.StateClosed)
 
 	return &CircuitBreaker{cb: cb}
 }
 
-func (cb *CircuitBreaker) Execute(ctx context.Context, req func() (interface{}, error)) (interface{}, error) {
-	return cb.cb.Execute(func() (interface{}, error) {
-		return req()
-	})
+// defaultLogger logs JSON to stderr for breakers created without a logger.
+func defaultLogger() *zap.Logger {
+	logger, err := zap.NewProduction()
+	if err != nil {
+		return zap.NewNop()
+	}
+	return logger
+}
+
+// Execute runs req through cb and returns its typed result. A ctx that is already done short-circuits
+// without calling req or touching the breaker counts. A ctx done while req runs returns ctx.Err() at
+// once; req gets ctx and should stop soon after, and its outcome is still counted when it does.
+// Each decision is traced as a span carrying the breaker's state and whether the call was allowed
+// or rejected; req runs inside that span.
+func Execute[T any](ctx context.Context, cb *CircuitBreaker, req func(ctx context.Context) (T, error)) (T, error) {
+	var zero T
+	if err := ctx.Err(); err != nil {
+		return zero, err
+	}
+
+	ctx, span := otel.Tracer("weather-api/pkg/circuitbreaker").Start(ctx, "circuit_breaker "+cb.Name())
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("circuit_breaker.name", cb.Name()),
+		attribute.String("circuit_breaker.state", cb.State().String()),
+	)
+
+	// req runs on its own goroutine so that cancellation does not wait for it. A panic in req is
+	// counted as a failure by gobreaker and re-raised here, on the caller's goroutine.
+	type outcome struct {
+		result   interface{}
+		err      error
+		panicked interface{}
+	}
+	done := make(chan outcome, 1)
+	go func() {
+		var out outcome
+		defer func() {
+			out.panicked = recover()
+			done <- out
+		}()
+		out.result, out.err = cb.cb.Execute(func() (interface{}, error) {
+			return req(ctx)
+		})
+	}()
+
+	var out outcome
+	select {
+	case out = <-done:
+		if out.panicked != nil {
+			panic(out.panicked)
+		}
+	case <-ctx.Done():
+		out.err = ctx.Err()
+	}
+
+	decision := "allowed"
+	if IsRejected(out.err) {
+		decision = "rejected"
+		cb.rejected.Add(1)
+		rejectionsTotal.WithLabelValues(cb.Name()).Inc()
+	}
+	span.SetAttributes(attribute.String("circuit_breaker.decision", decision))
+	if out.err != nil {
+		span.RecordError(out.err)
+		span.SetStatus(codes.Error, out.err.Error())
+		return zero, out.err
+	}
+
+	result, _ := out.result.(T)
+	return result, nil
 }
 
-func (cb *CircuitBreaker) State() gobreaker.State {
+func (cb *CircuitBreaker) State() State {
 	return cb.cb.State()
 }
+
+// Name returns the name the breaker was created with.
+func (cb *CircuitBreaker) Name() string {
+	return cb.cb.Name()
+}
+
+// Snapshot returns the breaker's current state and counts.
+func (cb *CircuitBreaker) Snapshot() Snapshot {
+	state := cb.cb.State()
+	counts := cb.cb.Counts()
+	return Snapshot{
+		Name:                 cb.cb.Name(),
+		State:                state,
+		Requests:             counts.Requests,
+		TotalSuccesses:       counts.TotalSuccesses,
+		TotalFailures:        counts.TotalFailures,
+		ConsecutiveSuccesses: counts.ConsecutiveSuccesses,
+		ConsecutiveFailures:  counts.ConsecutiveFailures,
+		Rejected:             cb.rejected.Load(),
+	}
+}
+
+// IsRejected reports whether err means the breaker refused to run the call.
+func IsRejected(err error) bool {
+	return errors.Is(err, ErrOpenState) || errors.Is(err, ErrTooManyRequests)
+}
@@ -0,0 +1,157 @@
+package circuitbreaker
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func testSettings() Settings {
+	return Settings{MaxRequests: 1, Interval: time.Minute, Timeout: time.Minute, MinRequests: 2, FailureRatio: 0.5}
+}
+
+func TestExecute_ReturnsTypedResult(t *testing.T) {
+	// Arrange
+	breaker := NewCircuitBreaker("test")
+
+	// Act
+	got, err := Execute(context.Background(), breaker, func(ctx context.Context) (int, error) { return 42, nil })
+
+	// Assert
+	if err != nil || got != 42 {
+		t.Errorf("Expected 42, got %d, %v", got, err)
+	}
+}
+
+func TestExecute_CanceledBeforeCallDoesNotRun(t *testing.T) {
+	// Arrange
+	breaker := NewCircuitBreaker("test")
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	called := false
+
+	// Act
+	_, err := Execute(ctx, breaker, func(ctx context.Context) (int, error) {
+		called = true
+		return 0, nil
+	})
+
+	// Assert
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+	if called {
+		t.Error("Expected the request not to run")
+	}
+	if snapshot := breaker.Snapshot(); snapshot.Requests != 0 {
+		t.Errorf("Expected no counted requests, got %d", snapshot.Requests)
+	}
+}
+
+func TestExecute_CanceledDuringCallReturnsEarly(t *testing.T) {
+	// Arrange
+	breaker := NewCircuitBreaker("test")
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	release := make(chan struct{})
+	defer close(release)
+
+	// Act
+	start := time.Now()
+	_, err := Execute(ctx, breaker, func(ctx context.Context) (int, error) {
+		<-release
+		return 1, nil
+	})
+
+	// Assert
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected context.DeadlineExceeded, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Expected Execute to return at the deadline, took %v", elapsed)
+	}
+}
+
+func TestExecute_IsSuccessfulHook(t *testing.T) {
+	// Arrange
+	errIgnored := errors.New("ignored")
+	settings := testSettings()
+	settings.IsSuccessful = func(err error) bool { return err == nil || errors.Is(err, errIgnored) }
+	breaker := NewCircuitBreakerWithSettings("test", settings, nil)
+
+	// Act
+	for i := 0; i < 3; i++ {
+		_, _ = Execute(context.Background(), breaker, func(ctx context.Context) (int, error) { return 0, errIgnored })
+	}
+
+	// Assert
+	snapshot := breaker.Snapshot()
+	if snapshot.State != StateClosed {
+		t.Errorf("Expected the breaker to stay closed, got %s", snapshot.State)
+	}
+	if snapshot.Requests != 3 || snapshot.TotalSuccesses != 3 || snapshot.TotalFailures != 0 {
+		t.Errorf("Expected 3 successful requests, got %+v", snapshot)
+	}
+}
+
+func TestExecute_OpensAndCountsRejections(t *testing.T) {
+	// Arrange
+	core, logs := observer.New(zapcore.InfoLevel)
+	breaker := NewCircuitBreakerWithSettings("test", testSettings(), zap.New(core))
+	fail := func(ctx context.Context) (int, error) { return 0, errors.New("boom") }
+
+	// Act
+	_, _ = Execute(context.Background(), breaker, fail)
+	_, _ = Execute(context.Background(), breaker, fail)
+	_, err := Execute(context.Background(), breaker, fail)
+
+	// Assert
+	if !IsRejected(err) {
+		t.Errorf("Expected the third call to be rejected, got %v", err)
+	}
+	snapshot := breaker.Snapshot()
+	if snapshot.Name != "test" || snapshot.State != StateOpen || snapshot.Rejected != 1 {
+		t.Errorf("Expected an open breaker with one rejection, got %+v", snapshot)
+	}
+
+	entries := logs.FilterMessage("circuit breaker state changed").All()
+	if len(entries) != 1 {
+		t.Fatalf("Expected one state change log, got %d", len(entries))
+	}
+	if entries[0].Level != zapcore.WarnLevel {
+		t.Errorf("Expected warn level on open, got %s", entries[0].Level)
+	}
+	fields := entries[0].ContextMap()
+	if fields["circuit_breaker"] != "test" || fields["from"] != "closed" || fields["to"] != "open" {
+		t.Errorf("Expected circuit_breaker, from and to fields, got %v", fields)
+	}
+}
+
+func TestNewCircuitBreakerWithSettings_OnStateChange(t *testing.T) {
+	// Arrange
+	type change struct {
+		name     string
+		from, to State
+	}
+	var changes []change
+	settings := testSettings()
+	settings.OnStateChange = func(name string, from State, to State) {
+		changes = append(changes, change{name, from, to})
+	}
+	breaker := NewCircuitBreakerWithSettings("test", settings, zap.NewNop())
+	fail := func(ctx context.Context) (int, error) { return 0, errors.New("boom") }
+
+	// Act
+	_, _ = Execute(context.Background(), breaker, fail)
+	_, _ = Execute(context.Background(), breaker, fail)
+
+	// Assert
+	if len(changes) != 1 || changes[0] != (change{"test", StateClosed, StateOpen}) {
+		t.Errorf("Expected one closed to open change, got %+v", changes)
+	}
+}
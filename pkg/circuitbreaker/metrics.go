@@ -0,0 +1,46 @@
+package circuitbreaker
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/sony/gobreaker"
+)
+
+// Breaker metrics, labeled by breaker name. The state gauge holds the last state the breaker
+// reported: 0 closed, 1 half-open, 2 open.
+var (
+	stateGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "circuit_breaker_state",
+		Help: "Current circuit breaker state (0 closed, 1 half-open, 2 open).",
+	}, []string{"name"})
+
+	transitionsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "circuit_breaker_transitions_total",
+		Help: "Circuit breaker state transitions.",
+	}, []string{"name", "from", "to"})
+
+	rejectionsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "circuit_breaker_rejections_total",
+		Help: "Calls refused by an open or saturated half-open circuit breaker.",
+	}, []string{"name"})
+)
+
+// recordState publishes a breaker's state and, when from differs from to, counts the transition.
+func recordState(name string, from gobreaker.State, to gobreaker.State) {
+	stateGauge.WithLabelValues(name).Set(stateValue(to))
+	if from != to {
+		transitionsTotal.WithLabelValues(name, from.String(), to.String()).Inc()
+	}
+}
+
+// stateValue maps a state to its gauge value.
+func stateValue(state gobreaker.State) float64 {
+	switch state {
+	case gobreaker.StateHalfOpen:
+		return 1
+	case gobreaker.StateOpen:
+		return 2
+	default:
+		return 0
+	}
+}
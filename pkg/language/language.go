@@ -0,0 +1,110 @@
+package language
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Code is a language understood by the OpenWeather "lang" parameter, e.g. "de" or "pt_br".
+type Code string
+
+// English is the language used when none is requested or none of the requested ones is supported.
+const English Code = "en"
+
+// supported maps normalized tags (lowercase, "_" separated) to provider codes. BCP 47 tags whose
+// OpenWeather code differs, such as "cs" (cz) or "ko" (kr), are listed as aliases.
+var supported = map[string]Code{
+	"af": "af", "ar": "ar", "az": "az", "bg": "bg", "ca": "ca", "da": "da", "de": "de",
+	"el": "el", "en": "en", "eu": "eu", "fa": "fa", "fi": "fi", "fr": "fr", "gl": "gl",
+	"he": "he", "hi": "hi", "hr": "hr", "hu": "hu", "id": "id", "it": "it", "ja": "ja",
+	"lt": "lt", "mk": "mk", "nl": "nl", "pl": "pl", "pt": "pt", "pt_br": "pt_br", "ro": "ro",
+	"ru": "ru", "sk": "sk", "sl": "sl", "sr": "sr", "th": "th", "tr": "tr", "vi": "vi",
+	"zh_cn": "zh_cn", "zh_tw": "zh_tw", "zu": "zu",
+
+	"al": "al", "sq": "al",
+	"cz": "cz", "cs": "cz",
+	"kr": "kr", "ko": "kr",
+	"la": "la", "lv": "la",
+	"no": "no", "nb": "no",
+	"sv": "sv", "se": "sv",
+	"es": "es", "sp": "es",
+	"uk": "uk", "ua": "uk",
+	"zh": "zh_cn", "zh_hans": "zh_cn", "zh_hant": "zh_tw", "zh_hk": "zh_tw",
+}
+
+// Parse returns the Code for a language tag such as "de", "de-AT" or "pt_BR", ignoring case and
+// surrounding spaces. Regional variants without a dedicated code fall back to their base language.
+// An empty value yields English.
+func Parse(value string) (Code, error) {
+	tag := strings.ReplaceAll(strings.ToLower(strings.TrimSpace(value)), "-", "_")
+	if tag == "" {
+		return English, nil
+	}
+	if code, ok := match(tag); ok {
+		return code, nil
+	}
+	return "", fmt.Errorf("unsupported lang %q", value)
+}
+
+// Negotiate picks the supported language with the highest quality from an Accept-Language header.
+// It returns English when the header is empty or names no supported language.
+func Negotiate(acceptLanguage string) Code {
+	type candidate struct {
+		tag     string
+		quality float64
+	}
+
+	var candidates []candidate
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		tag, params, _ := strings.Cut(part, ";")
+		tag = strings.ReplaceAll(strings.ToLower(strings.TrimSpace(tag)), "-", "_")
+		if tag == "" || tag == "*" {
+			continue
+		}
+
+		quality := 1.0
+		if q, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			parsed, err := strconv.ParseFloat(q, 64)
+			if err != nil {
+				continue
+			}
+			quality = parsed
+		}
+		if quality <= 0 {
+			continue
+		}
+		candidates = append(candidates, candidate{tag: tag, quality: quality})
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].quality > candidates[j].quality
+	})
+	for _, c := range candidates {
+		if code, ok := match(c.tag); ok {
+			return code
+		}
+	}
+	return English
+}
+
+// OrDefault returns c, or English when c is empty.
+func (c Code) OrDefault() Code {
+	if c == "" {
+		return English
+	}
+	return c
+}
+
+// match looks up a normalized tag, then its base language.
+func match(tag string) (Code, bool) {
+	if code, ok := supported[tag]; ok {
+		return code, true
+	}
+	if base, _, found := strings.Cut(tag, "_"); found {
+		code, ok := supported[base]
+		return code, ok
+	}
+	return "", false
+}
@@ -0,0 +1,40 @@
+package language
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	code, err := Parse(" DE ")
+	assert.NoError(t, err)
+	assert.Equal(t, Code("de"), code)
+
+	code, err = Parse("pt-BR")
+	assert.NoError(t, err)
+	assert.Equal(t, Code("pt_br"), code)
+
+	code, err = Parse("de-AT")
+	assert.NoError(t, err)
+	assert.Equal(t, Code("de"), code)
+
+	code, err = Parse("cs")
+	assert.NoError(t, err)
+	assert.Equal(t, Code("cz"), code)
+
+	code, err = Parse("")
+	assert.NoError(t, err)
+	assert.Equal(t, English, code)
+
+	_, err = Parse("klingon")
+	assert.Error(t, err)
+}
+
+func TestNegotiate(t *testing.T) {
+	assert.Equal(t, Code("de"), Negotiate("de-DE,de;q=0.9,en;q=0.8"))
+	assert.Equal(t, Code("fr"), Negotiate("en;q=0.5, fr;q=0.9"))
+	assert.Equal(t, Code("zh_tw"), Negotiate("xx, zh-TW;q=0.7"))
+	assert.Equal(t, English, Negotiate("de;q=0, *"))
+	assert.Equal(t, English, Negotiate(""))
+}
@@ -0,0 +1,161 @@
+// Package ratelimit implements keyed token buckets.
+package ratelimit
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"weather-api/pkg/cache"
+)
+
+// Limit allows Requests per Period. Buckets hold up to Requests tokens and refill evenly over Period,
+// so a client may burst the whole allowance at once and then continues at the average rate.
+type Limit struct {
+	Requests int
+	Period   time.Duration
+}
+
+// ParseLimit parses limits written as "<requests>/<period>", e.g. "60/1m" or "10/s". The period is
+// a Go duration; a bare unit means one of it.
+func ParseLimit(value string) (Limit, error) {
+	requests, period, ok := strings.Cut(strings.TrimSpace(value), "/")
+	if !ok {
+		return Limit{}, fmt.Errorf("invalid rate limit %q: want <requests>/<period>", value)
+	}
+
+	n, err := strconv.Atoi(strings.TrimSpace(requests))
+	if err != nil || n < 1 {
+		return Limit{}, fmt.Errorf("invalid rate limit %q: requests must be a positive integer", value)
+	}
+	period = strings.TrimSpace(period)
+	if period != "" && (period[0] < '0' || period[0] > '9') {
+		period = "1" + period
+	}
+	d, err := time.ParseDuration(period)
+	if err != nil || d <= 0 {
+		return Limit{}, fmt.Errorf("invalid rate limit %q: period must be a positive duration", value)
+	}
+
+	return Limit{Requests: n, Period: d}, nil
+}
+
+// String formats l the way ParseLimit reads it.
+func (l Limit) String() string {
+	return fmt.Sprintf("%d/%s", l.Requests, l.Period)
+}
+
+// perSecond returns the refill rate in tokens per second.
+func (l Limit) perSecond() float64 {
+	return float64(l.Requests) / l.Period.Seconds()
+}
+
+// Check asks for one token from the bucket named Key, which enforces Limit.
+type Check struct {
+	Key   string
+	Limit Limit
+}
+
+// Decision is the outcome of Allow. Limit, Remaining and Reset describe the most restrictive bucket
+// involved; Reset is the time until it is full again. RetryAfter is set when the request was denied
+// and is the time until every bucket has a token again.
+type Decision struct {
+	Allowed    bool
+	Limit      Limit
+	Remaining  int
+	Reset      time.Duration
+	RetryAfter time.Duration
+}
+
+type bucket struct {
+	tokens  float64
+	updated time.Time
+}
+
+// Limiter holds token buckets by key. Buckets not touched for a full refill period are dropped,
+// since a new bucket starts full anyway, and at most maxKeys buckets are kept.
+type Limiter struct {
+	mu      sync.Mutex
+	buckets *cache.LRU[string, *bucket]
+	now     func() time.Time
+}
+
+// NewLimiter creates a limiter tracking at most maxKeys buckets; the least recently used bucket is
+// dropped first. A non-positive maxKeys means unbounded.
+func NewLimiter(maxKeys int) *Limiter {
+	return &Limiter{
+		buckets: cache.NewLRU[string, *bucket](maxKeys),
+		now:     time.Now,
+	}
+}
+
+// Allow takes one token from every checked bucket if each of them has one, and none otherwise, so
+// a denied request costs nothing.
+func (l *Limiter) Allow(checks ...Check) Decision {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	buckets := make([]*bucket, len(checks))
+	allowed := true
+	for i, check := range checks {
+		b, ok := l.buckets.Get(check.Key)
+		if !ok {
+			b = &bucket{tokens: float64(check.Limit.Requests), updated: now}
+		}
+		elapsed := now.Sub(b.updated).Seconds()
+		b.tokens = math.Min(float64(check.Limit.Requests), b.tokens+elapsed*check.Limit.perSecond())
+		b.updated = now
+		buckets[i] = b
+		if b.tokens < 1 {
+			allowed = false
+		}
+	}
+
+	decision := Decision{Allowed: allowed, Remaining: math.MaxInt}
+	for i, check := range checks {
+		b := buckets[i]
+		if allowed {
+			b.tokens--
+		} else if b.tokens < 1 {
+			decision.RetryAfter = max(decision.RetryAfter, secondsToDuration((1-b.tokens)/check.Limit.perSecond()))
+		}
+		l.buckets.Set(check.Key, b, check.Limit.Period)
+
+		if remaining := int(b.tokens); remaining < decision.Remaining {
+			decision.Limit = check.Limit
+			decision.Remaining = remaining
+			decision.Reset = secondsToDuration((float64(check.Limit.Requests) - b.tokens) / check.Limit.perSecond())
+		}
+	}
+	if len(checks) == 0 {
+		decision.Remaining = 0
+	}
+	return decision
+}
+
+// Refund gives back the token Allow took for each check, up to the bucket's capacity. Buckets that
+// are no longer tracked start full anyway and are left alone.
+func (l *Limiter) Refund(checks ...Check) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	for _, check := range checks {
+		b, ok := l.buckets.Get(check.Key)
+		if !ok {
+			continue
+		}
+		elapsed := now.Sub(b.updated).Seconds()
+		b.tokens = math.Min(float64(check.Limit.Requests), b.tokens+elapsed*check.Limit.perSecond()+1)
+		b.updated = now
+		l.buckets.Set(check.Key, b, check.Limit.Period)
+	}
+}
+
+func secondsToDuration(seconds float64) time.Duration {
+	return time.Duration(seconds * float64(time.Second))
+}
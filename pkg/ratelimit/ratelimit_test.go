@@ -0,0 +1,128 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseLimit(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    Limit
+		wantErr bool
+	}{
+		{"60/1m", Limit{Requests: 60, Period: time.Minute}, false},
+		{" 10 / s ", Limit{Requests: 10, Period: time.Second}, false},
+		{"1000/24h", Limit{Requests: 1000, Period: 24 * time.Hour}, false},
+		{"60", Limit{}, true},
+		{"0/1m", Limit{}, true},
+		{"ten/1m", Limit{}, true},
+		{"10/0s", Limit{}, true},
+		{"10/fortnight", Limit{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			// Act
+			got, err := ParseLimit(tt.value)
+
+			// Assert
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Expected error %v, got %v", tt.wantErr, err)
+			}
+			if got != tt.want {
+				t.Errorf("Expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestLimiter_Allow_BurstThenRefill(t *testing.T) {
+	// Arrange
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	limiter := NewLimiter(100)
+	limiter.now = func() time.Time { return now }
+	check := Check{Key: "client", Limit: Limit{Requests: 3, Period: 3 * time.Second}}
+
+	// Act & Assert
+	for i := 2; i >= 0; i-- {
+		decision := limiter.Allow(check)
+		if !decision.Allowed || decision.Remaining != i {
+			t.Fatalf("Expected request to be allowed with %d remaining, got %+v", i, decision)
+		}
+	}
+
+	denied := limiter.Allow(check)
+	if denied.Allowed || denied.RetryAfter != time.Second || denied.Reset != 3*time.Second {
+		t.Errorf("Expected denial retrying after 1s and full after 3s, got %+v", denied)
+	}
+
+	now = now.Add(time.Second)
+	if decision := limiter.Allow(check); !decision.Allowed || decision.Remaining != 0 {
+		t.Errorf("Expected one refilled token after 1s, got %+v", decision)
+	}
+}
+
+func TestLimiter_Allow_AllOrNothing(t *testing.T) {
+	// Arrange
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	limiter := NewLimiter(100)
+	limiter.now = func() time.Time { return now }
+	client := Check{Key: "client", Limit: Limit{Requests: 10, Period: time.Minute}}
+	route := Check{Key: "client|/weather/batch", Limit: Limit{Requests: 1, Period: time.Minute}}
+
+	// Act
+	first := limiter.Allow(client, route)
+	second := limiter.Allow(client, route)
+	other := limiter.Allow(client)
+
+	// Assert
+	if !first.Allowed || first.Limit != route.Limit || first.Remaining != 0 {
+		t.Errorf("Expected the route bucket to be reported as most restrictive, got %+v", first)
+	}
+	if second.Allowed || second.RetryAfter != time.Minute {
+		t.Errorf("Expected the route limit to deny for a minute, got %+v", second)
+	}
+	if !other.Allowed || other.Remaining != 8 {
+		t.Errorf("Expected the denied request not to consume a client token, got %+v", other)
+	}
+}
+
+func TestLimiter_Allow_SeparateKeys(t *testing.T) {
+	// Arrange
+	limiter := NewLimiter(100)
+	limit := Limit{Requests: 1, Period: time.Hour}
+
+	// Act
+	a := limiter.Allow(Check{Key: "a", Limit: limit})
+	b := limiter.Allow(Check{Key: "b", Limit: limit})
+	again := limiter.Allow(Check{Key: "a", Limit: limit})
+
+	// Assert
+	if !a.Allowed || !b.Allowed || again.Allowed {
+		t.Errorf("Expected independent buckets per key, got %+v %+v %+v", a, b, again)
+	}
+}
+
+func TestLimiter_Refund(t *testing.T) {
+	// Arrange
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	limiter := NewLimiter(100)
+	limiter.now = func() time.Time { return now }
+	check := Check{Key: "client", Limit: Limit{Requests: 1, Period: time.Hour}}
+
+	// Act
+	first := limiter.Allow(check)
+	limiter.Refund(check)
+	limiter.Refund(check)
+	second := limiter.Allow(check)
+	denied := limiter.Allow(check)
+
+	// Assert
+	if !first.Allowed || !second.Allowed {
+		t.Errorf("Expected the refunded token to be taken again, got %+v %+v", first, second)
+	}
+	if denied.Allowed || denied.RetryAfter != time.Hour {
+		t.Errorf("Expected refunds capped at the bucket's capacity, got %+v", denied)
+	}
+}
@@ -0,0 +1,107 @@
+package units
+
+import (
+	"fmt"
+	"strings"
+)
+
+// System is a unit system for temperatures and speeds, named after the OpenWeather "units" values.
+//
+//	metric:   Celsius, meters per second
+//	imperial: Fahrenheit, miles per hour
+//	standard: Kelvin, meters per second
+type System string
+
+const (
+	Metric   System = "metric"
+	Imperial System = "imperial"
+	Standard System = "standard"
+)
+
+const (
+	kelvinOffset       = 273.15
+	metersPerSecondMPH = 0.44704
+)
+
+// Parse returns the System named by value, ignoring case and surrounding spaces.
+// An empty value yields Metric.
+func Parse(value string) (System, error) {
+	switch system := System(strings.ToLower(strings.TrimSpace(value))); system {
+	case "":
+		return Metric, nil
+	case Metric, Imperial, Standard:
+		return system, nil
+	default:
+		return "", fmt.Errorf("unknown units %q (expected %q, %q or %q)", value, Metric, Imperial, Standard)
+	}
+}
+
+// OrMetric returns s, or Metric when s is empty.
+func (s System) OrMetric() System {
+	if s == "" {
+		return Metric
+	}
+	return s
+}
+
+// Temperature converts a temperature from one system to another.
+func Temperature(value float64, from System, to System) float64 {
+	from, to = from.OrMetric(), to.OrMetric()
+	if from == to {
+		return value
+	}
+	return fromCelsius(toCelsius(value, from), to)
+}
+
+// TemperatureDelta converts a temperature difference from one system to another.
+// Unlike Temperature it applies only the scale, never the offset.
+func TemperatureDelta(value float64, from System, to System) float64 {
+	from, to = from.OrMetric(), to.OrMetric()
+	if from == to {
+		return value
+	}
+	if from == Imperial {
+		value = value * 5 / 9
+	}
+	if to == Imperial {
+		value = value * 9 / 5
+	}
+	return value
+}
+
+// Speed converts a speed from one system to another.
+func Speed(value float64, from System, to System) float64 {
+	from, to = from.OrMetric(), to.OrMetric()
+	if from == to {
+		return value
+	}
+	if from == Imperial {
+		value *= metersPerSecondMPH
+	}
+	if to == Imperial {
+		value /= metersPerSecondMPH
+	}
+	return value
+}
+
+func toCelsius(value float64, from System) float64 {
+	switch from {
+	case Imperial:
+		return (value - 32) * 5 / 9
+	case Standard:
+		return value - kelvinOffset
+	default:
+		return value
+	}
+}
+
+func fromCelsius(celsius float64, to System) float64 {
+	switch to {
+	case Imperial:
+		return celsius*9/5 + 32
+	case Standard:
+		return celsius + kelvinOffset
+	default:
+		return celsius
+	}
+}
@@ -0,0 +1,40 @@
+package units
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	system, err := Parse(" Imperial ")
+	assert.NoError(t, err)
+	assert.Equal(t, Imperial, system)
+
+	system, err = Parse("")
+	assert.NoError(t, err)
+	assert.Equal(t, Metric, system)
+
+	_, err = Parse("nautical")
+	assert.Error(t, err)
+}
+
+func TestTemperature(t *testing.T) {
+	assert.InDelta(t, 77.0, Temperature(25, Metric, Imperial), 1e-9)
+	assert.InDelta(t, 298.15, Temperature(25, Metric, Standard), 1e-9)
+	assert.InDelta(t, 25.0, Temperature(77, Imperial, Metric), 1e-9)
+	assert.InDelta(t, 32.0, Temperature(273.15, Standard, Imperial), 1e-9)
+	assert.Equal(t, 25.0, Temperature(25, "", Metric))
+}
+
+func TestTemperatureDelta(t *testing.T) {
+	assert.InDelta(t, 9.0, TemperatureDelta(5, Metric, Imperial), 1e-9)
+	assert.InDelta(t, 5.0, TemperatureDelta(5, Metric, Standard), 1e-9)
+	assert.InDelta(t, 5.0, TemperatureDelta(9, Imperial, Standard), 1e-9)
+}
+
+func TestSpeed(t *testing.T) {
+	assert.InDelta(t, 22.369, Speed(10, Metric, Imperial), 1e-3)
+	assert.InDelta(t, 10.0, Speed(22.369362920544, Imperial, Standard), 1e-9)
+	assert.Equal(t, 10.0, Speed(10, Standard, Metric))
+}